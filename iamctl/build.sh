@@ -161,7 +161,8 @@ do
     mkdir -p $iamctl_bin_dir
     destination="$iamctl_bin_dir/$output"
 
-    GOOS=$goos GOARCH=$goarch go build -gcflags=-trimpath=$GOPATH -asmflags=-trimpath=$GOPATH -o $destination $target
+    GOOS=$goos GOARCH=$goarch go build -gcflags=-trimpath=$GOPATH -asmflags=-trimpath=$GOPATH \
+        -ldflags "-X github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils.TOOL_VERSION=${build_version}" -o $destination $target
 
     pwd=`pwd`
     cd $buildPath
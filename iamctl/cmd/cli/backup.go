@@ -0,0 +1,90 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cli
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/cmd"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Back up all resources",
+	Long:  `You can back up all resources available in the target environment, including the secrets, into an archive`,
+	Run: func(cmd *cobra.Command, args []string) {
+		backupDirPath, _ := cmd.Flags().GetString("backupDir")
+		configFile, _ := cmd.Flags().GetString("config")
+		utils.PARALLELISM, _ = cmd.Flags().GetInt("parallelism")
+		reportFormat, _ := cmd.Flags().GetString("report-format")
+		reportFile, _ := cmd.Flags().GetString("report-file")
+		retainCount, _ := cmd.Flags().GetInt("retain-count")
+		retainDays, _ := cmd.Flags().GetInt("retain-days")
+		if err := utils.ValidateReportFormat(reportFormat); err != nil {
+			utils.ExitWithError(utils.EXIT_CODE_CONFIG_ERROR, err)
+		}
+
+		baseDir := utils.LoadConfigs(configFile)
+		if backupDirPath == "" {
+			backupDirPath = filepath.Join(baseDir, "backups")
+		}
+
+		// The archives of all tenants are kept in the same directory, since they are named by the tenant.
+		utils.RunForTenants(backupDirPath, func(tenantDirPath string) {
+			archivePath, err := utils.BackupResources(backupDirPath)
+			if err != nil {
+				utils.UpdateFailureSummary(utils.BACKUPS, utils.SERVER_CONFIGS.TenantDomain)
+				log.Println("Error when creating the backup archive.", err)
+				return
+			}
+			log.Println("Backup archive created at: " + archivePath)
+
+			removedArchives, err := utils.PruneBackups(backupDirPath, utils.SERVER_CONFIGS.TenantDomain, retainCount, retainDays)
+			if err != nil {
+				log.Println("Error when pruning the old backup archives.", err)
+			}
+			for _, removedArchive := range removedArchives {
+				log.Println("Removed the old backup archive: " + removedArchive)
+			}
+		})
+
+		utils.PrintSummary(utils.EXPORT)
+		if err := utils.WriteReport(reportFormat, reportFile, utils.EXPORT); err != nil {
+			log.Println("Error when writing the report.", err)
+		}
+		os.Exit(utils.GetExitCode())
+	},
+}
+
+func init() {
+
+	cmd.RootCmd.AddCommand(backupCmd)
+	backupCmd.Flags().StringP("backupDir", "o", "", "Path to the directory to store the backup archives")
+	backupCmd.Flags().StringP("config", "c", "", "Path to the environment specific config folder")
+	backupCmd.Flags().Int("retain-count", 0, "Number of the latest backup archives to keep for each tenant")
+	backupCmd.Flags().Int("retain-days", 0, "Number of days to keep the backup archives")
+	backupCmd.Flags().String("report-format", "", "Format of the report of the results: json or junit")
+	backupCmd.Flags().String("report-file", "", "Path to the file to write the report of the results")
+	backupCmd.Flags().IntP("parallelism", "p", utils.DEFAULT_PARALLELISM, "Number of resources of the same type to export concurrently")
+	backupCmd.MarkFlagRequired("config")
+}
@@ -0,0 +1,60 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/cmd"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Compare local resources with the target environment",
+	Long:  `You can compare the resources in a local directory with the resources deployed in the target environment`,
+	Run: func(cmd *cobra.Command, args []string) {
+		inputDirPath, _ := cmd.Flags().GetString("inputDir")
+		format, _ := cmd.Flags().GetString("format")
+		configFile, _ := cmd.Flags().GetString("config")
+		includePatterns, _ := cmd.Flags().GetStringArray("include")
+		excludePatterns, _ := cmd.Flags().GetStringArray("exclude")
+
+		baseDir := utils.LoadConfigs(configFile)
+		if err := utils.AddResourceFilters(includePatterns, excludePatterns); err != nil {
+			utils.ExitWithError(utils.EXIT_CODE_CONFIG_ERROR, err)
+		}
+		if inputDirPath == "" {
+			inputDirPath = baseDir
+		}
+
+		utils.DiffAllResources(inputDirPath, format)
+
+		utils.PrintDiffSummary()
+	},
+}
+
+func init() {
+
+	cmd.RootCmd.AddCommand(diffCmd)
+	diffCmd.Flags().StringP("inputDir", "i", "", "Path to the input directory")
+	diffCmd.Flags().StringP("format", "f", "yaml", "Format of the local files")
+	diffCmd.Flags().StringP("config", "c", "", "Path to the environment specific config folder")
+	diffCmd.Flags().StringArray("include", nil, "Resources to include in addition to the INCLUDE_ONLY config, as <resource type>:<pattern> or <resource type>")
+	diffCmd.Flags().StringArray("exclude", nil, "Resources to exclude in addition to the EXCLUDE config, as <resource type>:<pattern> or <resource type>")
+}
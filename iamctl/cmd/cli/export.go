@@ -0,0 +1,78 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cli
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/cmd"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+var exportResourceCmd = &cobra.Command{
+	Use:   "export <resource type> <resource name>...",
+	Short: "Export selected resources",
+	Long:  `You can export selected resources of a resource type available in the target environment`,
+	Args:  cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		outputDirPath, _ := cmd.Flags().GetString("outputDir")
+		format, _ := cmd.Flags().GetString("format")
+		configFile, _ := cmd.Flags().GetString("config")
+		utils.PARALLELISM, _ = cmd.Flags().GetInt("parallelism")
+		reportFormat, _ := cmd.Flags().GetString("report-format")
+		reportFile, _ := cmd.Flags().GetString("report-file")
+		utils.FAIL_FAST, _ = cmd.Flags().GetBool("fail-fast")
+		if err := utils.ValidateReportFormat(reportFormat); err != nil {
+			utils.ExitWithError(utils.EXIT_CODE_CONFIG_ERROR, err)
+		}
+		handler, err := utils.FindResourceHandler(args[0])
+		if err != nil {
+			utils.ExitWithError(utils.EXIT_CODE_CONFIG_ERROR, err)
+		}
+
+		baseDir := utils.LoadConfigs(configFile)
+		if outputDirPath == "" {
+			outputDirPath = baseDir
+		}
+
+		utils.RunForTenants(outputDirPath, func(tenantDirPath string) {
+			utils.ExportNamedResources(handler, tenantDirPath, format, args[1:])
+		})
+
+		utils.PrintSummary(utils.EXPORT)
+		if err := utils.WriteReport(reportFormat, reportFile, utils.EXPORT); err != nil {
+			log.Println("Error when writing the report.", err)
+		}
+		os.Exit(utils.GetExitCode())
+	},
+}
+
+func init() {
+
+	cmd.RootCmd.AddCommand(exportResourceCmd)
+	exportResourceCmd.Flags().StringP("outputDir", "o", "", "Path to the output directory")
+	exportResourceCmd.Flags().StringP("format", "f", "yaml", "Format of the exported files")
+	exportResourceCmd.Flags().StringP("config", "c", "", "Path to the environment specific config folder")
+	exportResourceCmd.Flags().Bool("fail-fast", false, "Stop processing resources after the first failure")
+	exportResourceCmd.Flags().String("report-format", "", "Format of the report of the results: json or junit")
+	exportResourceCmd.Flags().String("report-file", "", "Path to the file to write the report of the results")
+	exportResourceCmd.Flags().IntP("parallelism", "p", utils.DEFAULT_PARALLELISM, "Number of resources to export concurrently")
+}
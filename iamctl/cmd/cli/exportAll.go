@@ -19,12 +19,11 @@
 package cli
 
 import (
+	"log"
+	"os"
+
 	"github.com/spf13/cobra"
 	"github.com/wso2-extensions/identity-tools-cli/iamctl/cmd"
-	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/applications"
-	claims "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/claims"
-	identityproviders "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/identityProviders"
-	userstores "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/userStores"
 	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
 )
 
@@ -36,18 +35,38 @@ var exportAllCmd = &cobra.Command{
 		outputDirPath, _ := cmd.Flags().GetString("outputDir")
 		format, _ := cmd.Flags().GetString("format")
 		configFile, _ := cmd.Flags().GetString("config")
+		includePatterns, _ := cmd.Flags().GetStringArray("include")
+		excludePatterns, _ := cmd.Flags().GetStringArray("exclude")
+		utils.PARALLELISM, _ = cmd.Flags().GetInt("parallelism")
+		reportFormat, _ := cmd.Flags().GetString("report-format")
+		reportFile, _ := cmd.Flags().GetString("report-file")
+		utils.FAIL_FAST, _ = cmd.Flags().GetBool("fail-fast")
+		includeSubOrganizations, _ := cmd.Flags().GetBool("sub-organizations")
+		if err := utils.ValidateReportFormat(reportFormat); err != nil {
+			utils.ExitWithError(utils.EXIT_CODE_CONFIG_ERROR, err)
+		}
 
 		baseDir := utils.LoadConfigs(configFile)
+		if err := utils.AddResourceFilters(includePatterns, excludePatterns); err != nil {
+			utils.ExitWithError(utils.EXIT_CODE_CONFIG_ERROR, err)
+		}
 		if outputDirPath == "" {
 			outputDirPath = baseDir
 		}
 
-		claims.ExportAll(outputDirPath, format)
-		identityproviders.ExportAll(outputDirPath, format)
-		applications.ExportAll(outputDirPath, format)
-		userstores.ExportAll(outputDirPath, format)
+		utils.RunForTenants(outputDirPath, func(tenantDirPath string) {
+			if includeSubOrganizations {
+				utils.ExportAllOrganizations(tenantDirPath, format)
+			} else {
+				utils.ExportAllResources(tenantDirPath, format)
+			}
+		})
 
 		utils.PrintSummary(utils.EXPORT)
+		if err := utils.WriteReport(reportFormat, reportFile, utils.EXPORT); err != nil {
+			log.Println("Error when writing the report.", err)
+		}
+		os.Exit(utils.GetExitCode())
 	},
 }
 
@@ -57,4 +76,11 @@ func init() {
 	exportAllCmd.Flags().StringP("outputDir", "o", "", "Path to the output directory")
 	exportAllCmd.Flags().StringP("format", "f", "yaml", "Format of the exported files")
 	exportAllCmd.Flags().StringP("config", "c", "", "Path to the environment specific config folder")
+	exportAllCmd.Flags().StringArray("include", nil, "Resources to include in addition to the INCLUDE_ONLY config, as <resource type>:<pattern> or <resource type>")
+	exportAllCmd.Flags().StringArray("exclude", nil, "Resources to exclude in addition to the EXCLUDE config, as <resource type>:<pattern> or <resource type>")
+	exportAllCmd.Flags().Bool("fail-fast", false, "Stop processing resources after the first failure")
+	exportAllCmd.Flags().String("report-format", "", "Format of the report of the results: json or junit")
+	exportAllCmd.Flags().String("report-file", "", "Path to the file to write the report of the results")
+	exportAllCmd.Flags().Bool("sub-organizations", false, "Export the resources of the sub organizations into separate folders")
+	exportAllCmd.Flags().IntP("parallelism", "p", utils.DEFAULT_PARALLELISM, "Number of resources of the same type to export concurrently")
 }
@@ -0,0 +1,79 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cli
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/cmd"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+var importResourceCmd = &cobra.Command{
+	Use:   "import <resource type> <file>...",
+	Short: "Import selected resources",
+	Long:  `You can import the resources in selected local files of a resource type to the target environment`,
+	Args:  cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		configFile, _ := cmd.Flags().GetString("config")
+		utils.DRY_RUN, _ = cmd.Flags().GetBool("dry-run")
+		utils.PARALLELISM, _ = cmd.Flags().GetInt("parallelism")
+		reportFormat, _ := cmd.Flags().GetString("report-format")
+		reportFile, _ := cmd.Flags().GetString("report-file")
+		utils.FAIL_FAST, _ = cmd.Flags().GetBool("fail-fast")
+		if err := utils.ValidateReportFormat(reportFormat); err != nil {
+			utils.ExitWithError(utils.EXIT_CODE_CONFIG_ERROR, err)
+		}
+		handler, err := utils.FindResourceHandler(args[0])
+		if err != nil {
+			utils.ExitWithError(utils.EXIT_CODE_CONFIG_ERROR, err)
+		}
+
+		baseDir := utils.LoadConfigs(configFile)
+
+		// The same files are imported to each tenant, with the keyword mappings of the tenant.
+		utils.RunForTenants(baseDir, func(tenantDirPath string) {
+			utils.ImportLocalFiles(handler, args[1:])
+		})
+
+		if utils.DRY_RUN {
+			utils.PrintPlan()
+		} else {
+			utils.PrintSummary(utils.IMPORT)
+		}
+		if err := utils.WriteReport(reportFormat, reportFile, utils.IMPORT); err != nil {
+			log.Println("Error when writing the report.", err)
+		}
+		os.Exit(utils.GetExitCode())
+	},
+}
+
+func init() {
+
+	cmd.RootCmd.AddCommand(importResourceCmd)
+	importResourceCmd.Flags().StringP("config", "c", "", "Path to the environment specific config folder")
+	importResourceCmd.Flags().Bool("dry-run", false, "Preview the resources that would be created or updated without making any changes")
+	importResourceCmd.Flags().Bool("fail-fast", false, "Stop processing resources after the first failure")
+	importResourceCmd.Flags().String("report-format", "", "Format of the report of the results: json or junit")
+	importResourceCmd.Flags().String("report-file", "", "Path to the file to write the report of the results")
+	importResourceCmd.Flags().IntP("parallelism", "p", utils.DEFAULT_PARALLELISM, "Number of resources to import concurrently")
+	importResourceCmd.MarkFlagRequired("config")
+}
@@ -19,12 +19,11 @@
 package cli
 
 import (
+	"log"
+	"os"
+
 	"github.com/spf13/cobra"
 	"github.com/wso2-extensions/identity-tools-cli/iamctl/cmd"
-	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/applications"
-	claims "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/claims"
-	identityproviders "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/identityProviders"
-	userstores "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/userStores"
 	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
 )
 
@@ -35,18 +34,49 @@ var importAllCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		inputDirPath, _ := cmd.Flags().GetString("inputDir")
 		configFile, _ := cmd.Flags().GetString("config")
+		includePatterns, _ := cmd.Flags().GetStringArray("include")
+		excludePatterns, _ := cmd.Flags().GetStringArray("exclude")
+		utils.DRY_RUN, _ = cmd.Flags().GetBool("dry-run")
+		utils.PARALLELISM, _ = cmd.Flags().GetInt("parallelism")
+		reportFormat, _ := cmd.Flags().GetString("report-format")
+		reportFile, _ := cmd.Flags().GetString("report-file")
+		utils.FAIL_FAST, _ = cmd.Flags().GetBool("fail-fast")
+		utils.ROLLBACK_ON_FAILURE, _ = cmd.Flags().GetBool("rollback-on-failure")
+		utils.SNAPSHOT_DIR, _ = cmd.Flags().GetString("snapshot-dir")
+		if utils.ROLLBACK_ON_FAILURE {
+			// Stop importing at the first failure, since the changes are reverted anyway.
+			utils.FAIL_FAST = true
+		}
+		includeSubOrganizations, _ := cmd.Flags().GetBool("sub-organizations")
+		if err := utils.ValidateReportFormat(reportFormat); err != nil {
+			utils.ExitWithError(utils.EXIT_CODE_CONFIG_ERROR, err)
+		}
 
 		baseDir := utils.LoadConfigs(configFile)
+		if err := utils.AddResourceFilters(includePatterns, excludePatterns); err != nil {
+			utils.ExitWithError(utils.EXIT_CODE_CONFIG_ERROR, err)
+		}
 		if inputDirPath == "" {
 			inputDirPath = baseDir
 		}
 
-		claims.ImportAll(inputDirPath)
-		identityproviders.ImportAll(inputDirPath)
-		applications.ImportAll(inputDirPath)
-		userstores.ImportAll(inputDirPath)
+		utils.RunForTenants(inputDirPath, func(tenantDirPath string) {
+			if includeSubOrganizations {
+				utils.ImportAllOrganizations(tenantDirPath)
+			} else {
+				utils.ImportAllResources(tenantDirPath)
+			}
+		})
 
-		utils.PrintSummary(utils.IMPORT)
+		if utils.DRY_RUN {
+			utils.PrintPlan()
+		} else {
+			utils.PrintSummary(utils.IMPORT)
+		}
+		if err := utils.WriteReport(reportFormat, reportFile, utils.IMPORT); err != nil {
+			log.Println("Error when writing the report.", err)
+		}
+		os.Exit(utils.GetExitCode())
 	},
 }
 
@@ -55,5 +85,15 @@ func init() {
 	cmd.RootCmd.AddCommand(importAllCmd)
 	importAllCmd.Flags().StringP("inputDir", "i", "", "Path to the input directory")
 	importAllCmd.Flags().StringP("config", "c", "", "Path to the environment specific config folder")
+	importAllCmd.Flags().StringArray("include", nil, "Resources to include in addition to the INCLUDE_ONLY config, as <resource type>:<pattern> or <resource type>")
+	importAllCmd.Flags().StringArray("exclude", nil, "Resources to exclude in addition to the EXCLUDE config, as <resource type>:<pattern> or <resource type>")
+	importAllCmd.Flags().Bool("dry-run", false, "Preview the resources that would be created, updated or deleted without making any changes")
+	importAllCmd.Flags().Bool("fail-fast", false, "Stop processing resources after the first failure")
+	importAllCmd.Flags().Bool("rollback-on-failure", false, "Revert the changes made by the import if any of the operations fail")
+	importAllCmd.Flags().String("snapshot-dir", "", "Path to the directory to store the snapshot of the deployed resources taken before importing")
+	importAllCmd.Flags().String("report-format", "", "Format of the report of the results: json or junit")
+	importAllCmd.Flags().String("report-file", "", "Path to the file to write the report of the results")
+	importAllCmd.Flags().Bool("sub-organizations", false, "Import the resources of the sub organizations from separate folders")
+	importAllCmd.Flags().IntP("parallelism", "p", utils.DEFAULT_PARALLELISM, "Number of resources of the same type to import concurrently")
 	importAllCmd.MarkFlagRequired("config")
 }
@@ -0,0 +1,36 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cli
+
+// Import the resource type packages to register their resource handlers.
+import (
+	_ "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/apiResources"
+	_ "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/applications"
+	_ "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/branding"
+	_ "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/claims"
+	_ "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/emailTemplates"
+	_ "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/groups"
+	_ "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/identityProviders"
+	_ "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/oidcScopes"
+	_ "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/roles"
+	_ "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/serverConfigurations"
+	_ "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/smsTemplates"
+	_ "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/userStores"
+	_ "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/users"
+)
@@ -0,0 +1,85 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cli
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/cmd"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Restore resources from a backup",
+	Long:  `You can restore the resources in a backup archive to the target environment`,
+	Run: func(cmd *cobra.Command, args []string) {
+		archivePath, _ := cmd.Flags().GetString("archive")
+		configFile, _ := cmd.Flags().GetString("config")
+		force, _ := cmd.Flags().GetBool("force")
+		utils.DRY_RUN, _ = cmd.Flags().GetBool("dry-run")
+		utils.PARALLELISM, _ = cmd.Flags().GetInt("parallelism")
+		reportFormat, _ := cmd.Flags().GetString("report-format")
+		reportFile, _ := cmd.Flags().GetString("report-file")
+		utils.FAIL_FAST, _ = cmd.Flags().GetBool("fail-fast")
+		utils.ROLLBACK_ON_FAILURE, _ = cmd.Flags().GetBool("rollback-on-failure")
+		utils.SNAPSHOT_DIR, _ = cmd.Flags().GetString("snapshot-dir")
+		if utils.ROLLBACK_ON_FAILURE {
+			// Stop importing at the first failure, since the changes are reverted anyway.
+			utils.FAIL_FAST = true
+		}
+		if err := utils.ValidateReportFormat(reportFormat); err != nil {
+			utils.ExitWithError(utils.EXIT_CODE_CONFIG_ERROR, err)
+		}
+
+		utils.LoadConfigs(configFile)
+		if err := utils.RestoreBackup(archivePath, force); err != nil {
+			utils.ExitWithError(utils.EXIT_CODE_CONFIG_ERROR, "Error when restoring the backup.", err)
+		}
+
+		if utils.DRY_RUN {
+			utils.PrintPlan()
+		} else {
+			utils.PrintSummary(utils.IMPORT)
+		}
+		if err := utils.WriteReport(reportFormat, reportFile, utils.IMPORT); err != nil {
+			log.Println("Error when writing the report.", err)
+		}
+		os.Exit(utils.GetExitCode())
+	},
+}
+
+func init() {
+
+	cmd.RootCmd.AddCommand(restoreCmd)
+	restoreCmd.Flags().StringP("archive", "a", "", "Path to the backup archive to restore")
+	restoreCmd.Flags().StringP("config", "c", "", "Path to the environment specific config folder")
+	restoreCmd.Flags().Bool("force", false, "Restore the backup even if it was taken from a different environment")
+	restoreCmd.Flags().Bool("dry-run", false, "Preview the resources that would be created, updated or deleted without making any changes")
+	restoreCmd.Flags().Bool("fail-fast", false, "Stop processing resources after the first failure")
+	restoreCmd.Flags().Bool("rollback-on-failure", false, "Revert the changes made by the restore if any of the operations fail")
+	restoreCmd.Flags().String("snapshot-dir", "", "Path to the directory to store the snapshot of the deployed resources taken before restoring")
+	restoreCmd.Flags().String("report-format", "", "Format of the report of the results: json or junit")
+	restoreCmd.Flags().String("report-file", "", "Path to the file to write the report of the results")
+	restoreCmd.Flags().IntP("parallelism", "p", utils.DEFAULT_PARALLELISM, "Number of resources of the same type to import concurrently")
+	restoreCmd.MarkFlagRequired("config")
+	restoreCmd.MarkFlagRequired("archive")
+}
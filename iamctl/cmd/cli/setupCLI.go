@@ -29,12 +29,16 @@ import (
 	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
 )
 
-var serverConfigTemplate = map[string]string{
-
-	utils.SERVER_URL_CONFIG:    "",
-	utils.CLIENT_ID_CONFIG:     "",
-	utils.CLIENT_SECRET_CONFIG: "",
-	utils.TENANT_DOMAIN_CONFIG: "",
+var serverConfigTemplate = map[string]interface{}{
+
+	utils.SERVER_URL_CONFIG:           "",
+	utils.CLIENT_ID_CONFIG:            "",
+	utils.CLIENT_SECRET_CONFIG:        "",
+	utils.TENANT_DOMAIN_CONFIG:        "",
+	utils.CA_CERT_PATH_CONFIG:         "",
+	utils.CLIENT_CERT_PATH_CONFIG:     "",
+	utils.CLIENT_KEY_PATH_CONFIG:      "",
+	utils.INSECURE_SKIP_VERIFY_CONFIG: false,
 }
 
 var setupCmd = &cobra.Command{
@@ -20,7 +20,6 @@ package interactive
 
 import (
 	"bytes"
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -50,8 +49,6 @@ func createSPBasicApplication(spName string, spDescription string) {
 		log.Fatalln(err)
 	}
 
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-
 	req, err := http.NewRequest("POST", ADDAPPURL, bytes.NewBuffer(jsonData))
 	if err != nil {
 		log.Fatalln(err)
@@ -62,8 +59,11 @@ func createSPBasicApplication(spName string, spDescription string) {
 
 	defer req.Body.Close()
 
-	httpClient := &http.Client{}
-	resp, err := httpClient.Do(req)
+	client, err := utils.GetHttpClient()
+	if err != nil {
+		log.Fatalln(err)
+	}
+	resp, err := client.Do(req)
 	if err != nil {
 		log.Fatalln(err)
 	}
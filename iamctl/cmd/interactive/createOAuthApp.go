@@ -20,7 +20,6 @@ package interactive
 
 import (
 	"bytes"
-	"crypto/tls"
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
@@ -139,8 +138,6 @@ func createSPOauthApplication(oauthAppName string, description string, callbackU
 		log.Fatalln(err)
 	}
 
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-
 	req, err := http.NewRequest("POST", ADDAPPURL, bytes.NewBuffer(jsonData))
 	if err != nil {
 		log.Fatalln(err)
@@ -151,8 +148,11 @@ func createSPOauthApplication(oauthAppName string, description string, callbackU
 
 	defer req.Body.Close()
 
-	httpClient := &http.Client{}
-	resp, err := httpClient.Do(req)
+	client, err := utils.GetHttpClient()
+	if err != nil {
+		log.Fatalln(err)
+	}
+	resp, err := client.Do(req)
 	if err != nil {
 		log.Fatalln(err)
 	}
@@ -175,8 +175,6 @@ func createSPOauthApplication(oauthAppName string, description string, callbackU
 		splits := strings.SplitAfter(location, "applications/")
 		serviceProviderID := splits[1]
 
-		http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-
 		req, _ := http.NewRequest("GET", ADDAPPURL+"/"+serviceProviderID+"/export", bytes.NewBuffer(nil))
 		query := req.URL.Query()
 		query.Add("exportSecrets", "true")
@@ -186,8 +184,11 @@ func createSPOauthApplication(oauthAppName string, description string, callbackU
 
 		defer req.Body.Close()
 
-		httpClient := &http.Client{}
-		resp, err := httpClient.Do(req)
+		client, err := utils.GetHttpClient()
+		if err != nil {
+			log.Fatalln(err)
+		}
+		resp, err := client.Do(req)
 		if err != nil {
 			log.Fatalln(err)
 		}
@@ -19,7 +19,6 @@
 package interactive
 
 import (
-	"crypto/tls"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -112,15 +111,11 @@ func exportApplication(serviceProviderID string, exportlocation string, fileType
 	req.Header.Set("Authorization", "Bearer "+token)
 	defer req.Body.Close()
 
-	httpClient := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
+	client, err := utils.GetHttpClient()
+	if err != nil {
+		log.Fatalln(err)
 	}
-
-	resp, err := httpClient.Do(req)
+	resp, err := client.Do(req)
 	if err != nil {
 		log.Fatalln(err)
 	}
@@ -20,7 +20,6 @@ package interactive
 
 import (
 	"bytes"
-	"crypto/tls"
 	"fmt"
 	"io"
 	"log"
@@ -100,14 +99,10 @@ func importApplication(importFilePath string) {
 	if err != nil {
 		log.Fatal(err)
 	}
-	client := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
+	client, err := utils.GetHttpClient()
+	if err != nil {
+		log.Fatal(err)
 	}
-
 	resp, err := client.Do(request)
 	if err != nil {
 		log.Fatal(err)
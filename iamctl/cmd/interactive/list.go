@@ -20,7 +20,6 @@ package interactive
 
 import (
 	"bytes"
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -134,15 +133,16 @@ func getList() {
 
 	token := utils.ReadFile()
 
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-
 	req, _ := http.NewRequest("GET", GETLISTURL, bytes.NewBuffer(nil))
 	req.Header.Set("Authorization", "Bearer "+token)
 	req.Header.Set("accept", "*/*")
 	defer req.Body.Close()
 
-	httpClient := &http.Client{}
-	resp, err := httpClient.Do(req)
+	client, err := utils.GetHttpClient()
+	if err != nil {
+		log.Fatalln(err)
+	}
+	resp, err := client.Do(req)
 	if err != nil {
 		log.Fatalln(err)
 	}
@@ -19,7 +19,6 @@
 package interactive
 
 import (
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -98,15 +97,11 @@ func sendOAuthRequest(userName string, password string) (string, string) {
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	defer req.Body.Close()
 
-	httpClient := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
+	client, err := utils.GetHttpClient()
+	if err != nil {
+		log.Fatalln(err)
 	}
-
-	resp, err := httpClient.Do(req)
+	resp, err := client.Do(req)
 	if err != nil {
 		log.Fatalln(err)
 	}
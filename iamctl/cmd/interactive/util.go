@@ -20,7 +20,6 @@ package interactive
 
 import (
 	"bytes"
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -101,7 +100,6 @@ func callArtifactServiceApi(technology string, application string) *http.Respons
 
 	serverDetails := readServerDetails()
 	token := serverDetails.AccessToken
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
 	artifactServiceUrl := serverDetails.Server + "/artifact-service/service/artifact/" + technology
 
 	toJson := ServerInfo{
@@ -124,8 +122,12 @@ func callArtifactServiceApi(technology string, application string) *http.Respons
 	req.Header.Set("Content-Type", "application/json")
 	defer req.Body.Close()
 
-	httpClient := &http.Client{}
-	resp, err := httpClient.Do(req)
+	client, err := utils.GetHttpClient()
+	if err != nil {
+		fmt.Println(err)
+		return nil
+	}
+	resp, err := client.Do(req)
 	if err != nil {
 		fmt.Println("Error while getting response from artifact-service")
 		return nil
@@ -0,0 +1,167 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package apiresources
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+// Only the API resources added by the users are handled by the tool, since the system API resources are the same
+// in all environments.
+const BUSINESS_API_TYPE = "BUSINESS"
+const API_RESOURCE_PAGE_SIZE = 100
+
+type apiResourceList struct {
+	ApiResources []apiResource `json:"apiResources"`
+	Links        []struct {
+		Href string `json:"href"`
+		Rel  string `json:"rel"`
+	} `json:"links"`
+}
+
+type apiResource struct {
+	Id                    string  `json:"id,omitempty" yaml:"-"`
+	Name                  string  `json:"name" yaml:"name"`
+	Identifier            string  `json:"identifier" yaml:"identifier"`
+	Description           string  `json:"description" yaml:"description"`
+	RequiresAuthorization bool    `json:"requiresAuthorization" yaml:"requiresAuthorization"`
+	Type                  string  `json:"type,omitempty" yaml:"-"`
+	Scopes                []scope `json:"scopes" yaml:"scopes"`
+}
+
+type scope struct {
+	Name        string `json:"name" yaml:"name"`
+	DisplayName string `json:"displayName" yaml:"displayName"`
+	Description string `json:"description" yaml:"description"`
+}
+
+func getApiResourceList() ([]apiResource, error) {
+
+	var apiResources []apiResource
+	after := ""
+	for {
+		query := url.Values{}
+		query.Set("limit", fmt.Sprint(API_RESOURCE_PAGE_SIZE))
+		if after != "" {
+			query.Set("after", after)
+		}
+		body, _, err := utils.SendJsonRequest(http.MethodGet, getApiResourceUrl("")+"?"+query.Encode(), nil, utils.API_RESOURCES)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve available API resource list. %w", err)
+		}
+		var list apiResourceList
+		if err := json.Unmarshal(body, &list); err != nil {
+			return nil, fmt.Errorf("error when unmarshalling the retrieved API resource list. %w", err)
+		}
+		apiResources = append(apiResources, list.ApiResources...)
+
+		// Continue with the cursor of the next page, if available.
+		after = ""
+		for _, link := range list.Links {
+			if link.Rel != "next" {
+				continue
+			}
+			if nextUrl, err := url.Parse(link.Href); err == nil {
+				after = nextUrl.Query().Get("after")
+			}
+		}
+		if after == "" || len(list.ApiResources) == 0 {
+			return apiResources, nil
+		}
+	}
+}
+
+func getApiResource(apiResourceId string) (apiResource, error) {
+
+	var resource apiResource
+	body, _, err := utils.SendJsonRequest(http.MethodGet, getApiResourceUrl(apiResourceId), nil, utils.API_RESOURCES)
+	if err != nil {
+		return resource, err
+	}
+	if err := json.Unmarshal(body, &resource); err != nil {
+		return resource, fmt.Errorf("error when unmarshalling the retrieved API resource. %w", err)
+	}
+	return resource, nil
+}
+
+func getApiResourceUrl(apiResourceId string) string {
+
+	if apiResourceId == "" {
+		return utils.GetServerBaseUrl() + "/api/server/v1/api-resources"
+	}
+	return utils.GetServerBaseUrl() + "/api/server/v1/api-resources/" + apiResourceId
+}
+
+func getScopeUrl(apiResourceId string, scopeName string) string {
+
+	return getApiResourceUrl(apiResourceId) + "/scopes/" + url.PathEscape(scopeName)
+}
+
+// syncScopes adds the new scopes of the API resource, updates the changed scopes and removes the scopes that are not
+// defined in the file.
+func syncScopes(apiResourceId string, localScopes []scope, deployedScopes []scope) error {
+
+	deployedScopeMap := make(map[string]scope)
+	for _, deployedScope := range deployedScopes {
+		deployedScopeMap[deployedScope.Name] = deployedScope
+	}
+
+	var addedScopes []scope
+	localScopeNames := make(map[string]bool)
+	for _, localScope := range localScopes {
+		localScopeNames[localScope.Name] = true
+		deployedScope, ok := deployedScopeMap[localScope.Name]
+		if !ok {
+			addedScopes = append(addedScopes, localScope)
+		} else if deployedScope != localScope {
+			body := map[string]string{"displayName": localScope.DisplayName, "description": localScope.Description}
+			_, _, err := utils.SendJsonRequest(http.MethodPatch, getScopeUrl(apiResourceId, localScope.Name), body, utils.API_RESOURCES)
+			if err != nil {
+				return fmt.Errorf("error when updating the scope: %s. %s", localScope.Name, err)
+			}
+		}
+	}
+	if len(addedScopes) > 0 {
+		body := map[string]interface{}{"addedScopes": addedScopes}
+		_, _, err := utils.SendJsonRequest(http.MethodPatch, getApiResourceUrl(apiResourceId), body, utils.API_RESOURCES)
+		if err != nil {
+			return fmt.Errorf("error when adding the new scopes. %s", err)
+		}
+	}
+	for _, deployedScope := range deployedScopes {
+		if !localScopeNames[deployedScope.Name] {
+			_, _, err := utils.SendJsonRequest(http.MethodDelete, getScopeUrl(apiResourceId, deployedScope.Name), nil, utils.API_RESOURCES)
+			if err != nil {
+				return fmt.Errorf("error when removing the scope: %s. %s", deployedScope.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func formatFileName(identifier string) string {
+
+	return regexp.MustCompile(`[^\w\d-]+`).ReplaceAllString(identifier, "_")
+}
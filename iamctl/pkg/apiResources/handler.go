@@ -0,0 +1,163 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package apiresources
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+type apiResourceHandler struct{}
+
+func init() {
+
+	utils.RegisterResourceHandler(&apiResourceHandler{})
+}
+
+func (h *apiResourceHandler) GetResourceType() string {
+
+	return utils.API_RESOURCES
+}
+
+func (h *apiResourceHandler) GetConfigKey() string {
+
+	return utils.API_RESOURCES_CONFIG
+}
+
+func (h *apiResourceHandler) GetDependencies() []string {
+
+	return nil
+}
+
+func (h *apiResourceHandler) GetArrayIdentifiers() map[string]string {
+
+	return map[string]string{
+		"scopes": "name",
+	}
+}
+
+func (h *apiResourceHandler) GetDeployedResources() ([]utils.Resource, error) {
+
+	apiResources, err := getApiResourceList()
+	if err != nil {
+		return nil, err
+	}
+	var resources []utils.Resource
+	for _, apiResource := range apiResources {
+		if apiResource.Type == BUSINESS_API_TYPE {
+			// API resources are identified by the identifier, since the names are not unique.
+			resources = append(resources, utils.Resource{Id: apiResource.Id, Name: apiResource.Identifier})
+		}
+	}
+	return resources, nil
+}
+
+func (h *apiResourceHandler) GetFileName(resource utils.Resource) string {
+
+	return formatFileName(resource.Name)
+}
+
+func (h *apiResourceHandler) ExportResource(resource utils.Resource, format string, excludeSecrets bool) (string, []byte, error) {
+
+	apiResource, err := getApiResource(resource.Id)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while exporting the API resource: %s", err)
+	}
+	content, err := yaml.Marshal(apiResource)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while converting the exported API resource: %s", err)
+	}
+	return formatFileName(resource.Name) + ".yml", content, nil
+}
+
+func (h *apiResourceHandler) ResolveLocalResource(fileData []byte, fileInfo utils.FileInfo,
+	inventory *utils.ResourceInventory) (utils.Resource, error) {
+
+	var localApiResource apiResource
+	if err := yaml.Unmarshal(fileData, &localApiResource); err != nil {
+		return utils.Resource{}, fmt.Errorf("invalid file content for API resource: %s. %s", fileInfo.ResourceName, err)
+	}
+	if localApiResource.Identifier == "" {
+		return utils.Resource{}, fmt.Errorf("identifier is not defined for API resource: %s", fileInfo.ResourceName)
+	}
+
+	resource := utils.Resource{Name: localApiResource.Identifier}
+	if deployedResource, ok := inventory.GetByName(localApiResource.Identifier); ok {
+		resource.Id = deployedResource.Id
+	}
+	return resource, nil
+}
+
+func (h *apiResourceHandler) ImportResource(resource utils.Resource, filePath string, fileData string) (string, error) {
+
+	var localApiResource apiResource
+	if err := yaml.Unmarshal([]byte(fileData), &localApiResource); err != nil {
+		return "", fmt.Errorf("invalid file content for API resource: %s", err)
+	}
+	if localApiResource.Scopes == nil {
+		localApiResource.Scopes = []scope{}
+	}
+
+	body, _, err := utils.SendJsonRequest(http.MethodPost, getApiResourceUrl(""), localApiResource, utils.API_RESOURCES)
+	if err != nil {
+		return "", err
+	}
+	var createdApiResource apiResource
+	if err := json.Unmarshal(body, &createdApiResource); err != nil {
+		return "", nil
+	}
+	return createdApiResource.Id, nil
+}
+
+func (h *apiResourceHandler) UpdateResource(resource utils.Resource, filePath string, fileData string) error {
+
+	var localApiResource apiResource
+	if err := yaml.Unmarshal([]byte(fileData), &localApiResource); err != nil {
+		return fmt.Errorf("invalid file content for API resource: %s", err)
+	}
+	deployedApiResource, err := getApiResource(resource.Id)
+	if err != nil {
+		return err
+	}
+
+	// The identifier and the authorization requirement of an API resource cannot be updated.
+	if localApiResource.RequiresAuthorization != deployedApiResource.RequiresAuthorization {
+		return fmt.Errorf("requiresAuthorization of an API resource cannot be updated")
+	}
+	body := map[string]string{"name": localApiResource.Name, "description": localApiResource.Description}
+	if _, _, err := utils.SendJsonRequest(http.MethodPatch, getApiResourceUrl(resource.Id), body, utils.API_RESOURCES); err != nil {
+		return err
+	}
+	return syncScopes(resource.Id, localApiResource.Scopes, deployedApiResource.Scopes)
+}
+
+func (h *apiResourceHandler) DeleteResource(resource utils.Resource) error {
+
+	_, _, err := utils.SendJsonRequest(http.MethodDelete, getApiResourceUrl(resource.Id), nil, utils.API_RESOURCES)
+	return err
+}
+
+func (h *apiResourceHandler) IsDeletable(resource utils.Resource) bool {
+
+	return true
+}
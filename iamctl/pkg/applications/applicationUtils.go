@@ -23,11 +23,8 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"os"
-	"path/filepath"
 	"regexp"
 	"strings"
-	"text/tabwriter"
 
 	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
 	"gopkg.in/yaml.v2"
@@ -59,17 +56,7 @@ type AuthConfig struct {
 	} `yaml:"inboundAuthenticationConfig"`
 }
 
-func getDeployedAppNames() []string {
-
-	apps := getAppList()
-	var appNames []string
-	for _, app := range apps {
-		appNames = append(appNames, app.Name)
-	}
-	return appNames
-}
-
-func getAppList() (spIdList []Application) {
+func getAppList() ([]Application, error) {
 
 	totalAppCount, err := getTotalAppCount()
 	if err != nil {
@@ -78,7 +65,7 @@ func getAppList() (spIdList []Application) {
 	var list AppList
 	resp, err := utils.SendGetListRequest(utils.APPLICATIONS, totalAppCount)
 	if err != nil {
-		log.Println("Error while retrieving application list", err)
+		return nil, fmt.Errorf("error while retrieving application list. %w", err)
 	}
 	defer resp.Body.Close()
 
@@ -86,25 +73,18 @@ func getAppList() (spIdList []Application) {
 	if statusCode == 200 {
 		body, err := ioutil.ReadAll(resp.Body)
 		if err != nil {
-			log.Fatalln(err)
+			return nil, fmt.Errorf("error when reading the retrieved application list. %w", err)
 		}
-		writer := new(tabwriter.Writer)
-		writer.Init(os.Stdout, 8, 8, 0, '\t', 0)
-		defer writer.Flush()
 
 		err = json.Unmarshal(body, &list)
 		if err != nil {
-			log.Fatalln(err)
+			return nil, fmt.Errorf("error when unmarshalling the retrieved application list. %w", err)
 		}
-		resp.Body.Close()
-
-		spIdList = list.Applications
+		return list.Applications, nil
 	} else if error, ok := utils.ErrorCodes[statusCode]; ok {
-		log.Println(error)
-	} else {
-		log.Println("Error while retrieving application list")
+		return nil, fmt.Errorf("error while retrieving application list. Status code: %d, Error: %s", statusCode, error)
 	}
-	return spIdList
+	return nil, fmt.Errorf("error while retrieving application list. Status code: %d", statusCode)
 }
 
 func getTotalAppCount() (count int, err error) {
@@ -136,14 +116,6 @@ func getTotalAppCount() (count int, err error) {
 	return -1, fmt.Errorf("error while retrieving application count")
 }
 
-func getAppKeywordMapping(appName string) map[string]interface{} {
-
-	if utils.KEYWORD_CONFIGS.ApplicationConfigs != nil {
-		return utils.ResolveAdvancedKeywordMapping(appName, utils.KEYWORD_CONFIGS.ApplicationConfigs)
-	}
-	return utils.KEYWORD_CONFIGS.KeywordMappings
-}
-
 func isOauthApp(fileData string) (bool, error) {
 
 	config, err := unmarshalAuthConfig([]byte(fileData))
@@ -178,12 +150,18 @@ func maskOAuthConsumerSecret(fileContent []byte) []byte {
 	return []byte(maskedContent)
 }
 
-func isToolMgtApp(file os.FileInfo, importFilePath string) (bool, error) {
+func isToolMgtApp(app utils.Resource) (bool, error) {
+
+	// Export the deployed application to check if it is the application used by the tool.
+	resp, err := utils.SendExportRequest(app.Id, utils.MEDIA_TYPE_YAML, utils.APPLICATIONS, true)
+	if err != nil {
+		return false, fmt.Errorf("failed to export application: %s", err.Error())
+	}
+	defer resp.Body.Close()
 
-	appFilePath := filepath.Join(importFilePath, file.Name())
-	fileData, err := ioutil.ReadFile(appFilePath)
+	fileData, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return false, fmt.Errorf("failed to read file: %s", err.Error())
+		return false, fmt.Errorf("failed to read exported application: %s", err.Error())
 	}
 
 	config, err := unmarshalAuthConfig(fileData)
@@ -193,8 +171,7 @@ func isToolMgtApp(file os.FileInfo, importFilePath string) (bool, error) {
 
 	for _, requestConfig := range config.InboundAuthenticationConfig.InboundAuthenticationRequestConfigs {
 		if requestConfig.InboundAuthKey == utils.SERVER_CONFIGS.ClientId {
-			appName := strings.TrimSuffix(file.Name(), filepath.Ext(file.Name()))
-			log.Printf("Info: Tool Management App: %s is excluded from deletion.\n", appName)
+			log.Printf("Info: Tool Management App: %s is excluded from deletion.\n", app.Name)
 			return true, nil
 		}
 	}
@@ -0,0 +1,78 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package applications
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+const INBOUND_PROTOCOL_ATTRIBUTE = "inboundProtocol"
+
+// Inbound protocol types used in the exported files, by the types returned by the inbound protocols API.
+var inboundAuthTypes = map[string]string{
+	"samlsso":    "saml",
+	"passivests": "passive-sts",
+	"wstrust":    "ws-trust",
+}
+
+// GetDeployedAttributes returns the inbound protocol types of the deployed application.
+func (h *applicationHandler) GetDeployedAttributes(resource utils.Resource) (map[string][]string, error) {
+
+	reqUrl := utils.GetServerBaseUrl() + "/api/server/v1/applications/" + resource.Id + "/inbound-protocols"
+	body, _, err := utils.SendJsonRequest(http.MethodGet, reqUrl, nil, utils.APPLICATIONS)
+	if err != nil {
+		return nil, fmt.Errorf("error when retrieving the inbound protocols of the application: %s. %s", resource.Name, err)
+	}
+	var inboundProtocols []struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(body, &inboundProtocols); err != nil {
+		return nil, fmt.Errorf("error when unmarshalling the inbound protocols of the application: %s. %s", resource.Name, err)
+	}
+
+	attributes := map[string][]string{INBOUND_PROTOCOL_ATTRIBUTE: {}}
+	for _, inboundProtocol := range inboundProtocols {
+		attributes[INBOUND_PROTOCOL_ATTRIBUTE] = append(attributes[INBOUND_PROTOCOL_ATTRIBUTE], inboundProtocol.Type)
+	}
+	return attributes, nil
+}
+
+// GetLocalAttributes returns the inbound protocol types of the application in the local file.
+func (h *applicationHandler) GetLocalAttributes(fileData []byte) (map[string][]string, error) {
+
+	var appConfig AuthConfig
+	if err := yaml.Unmarshal(fileData, &appConfig); err != nil {
+		return nil, fmt.Errorf("invalid file content for application. %s", err)
+	}
+
+	attributes := map[string][]string{INBOUND_PROTOCOL_ATTRIBUTE: {}}
+	for _, inboundConfig := range appConfig.InboundAuthenticationConfig.InboundAuthenticationRequestConfigs {
+		protocolType := inboundConfig.InboundAuthType
+		if apiType, ok := inboundAuthTypes[protocolType]; ok {
+			protocolType = apiType
+		}
+		attributes[INBOUND_PROTOCOL_ATTRIBUTE] = append(attributes[INBOUND_PROTOCOL_ATTRIBUTE], protocolType)
+	}
+	return attributes, nil
+}
@@ -0,0 +1,242 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package applications
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+const AUTHORIZED_APIS_FILE_SUFFIX = ".authorized-apis.yml"
+const DEFAULT_AUTHORIZATION_POLICY = "RBAC"
+
+type authorizedApi struct {
+	Id               string `json:"id"`
+	Identifier       string `json:"identifier"`
+	PolicyId         string `json:"policyId"`
+	AuthorizedScopes []struct {
+		Name string `json:"name"`
+	} `json:"authorizedScopes"`
+}
+
+// AuthorizedApiConfig is the format of an authorized API in the authorized APIs file of an application.
+type AuthorizedApiConfig struct {
+	Identifier       string   `yaml:"identifier"`
+	PolicyIdentifier string   `yaml:"policyIdentifier"`
+	Scopes           []string `yaml:"scopes"`
+}
+
+// getAuthorizedApis returns the APIs authorized for the application. The returned flag is false if API authorization
+// is not supported by the target environment.
+func getAuthorizedApis(appId string) ([]authorizedApi, bool, error) {
+
+	body, statusCode, err := utils.SendJsonRequest(http.MethodGet, getAuthorizedApiUrl(appId, ""), nil, utils.APPLICATIONS)
+	if statusCode == http.StatusNotFound {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("error when retrieving the authorized APIs. %s", err)
+	}
+	var authorizedApis []authorizedApi
+	if err := json.Unmarshal(body, &authorizedApis); err != nil {
+		return nil, false, fmt.Errorf("error when unmarshalling the authorized APIs. %s", err)
+	}
+	return authorizedApis, true, nil
+}
+
+func getAuthorizedApiUrl(appId string, apiId string) string {
+
+	authorizedApiUrl := utils.GetServerBaseUrl() + "/api/server/v1/applications/" + appId + "/authorized-apis"
+	if apiId == "" {
+		return authorizedApiUrl
+	}
+	return authorizedApiUrl + "/" + apiId
+}
+
+// exportAuthorizedApis returns the content of the authorized APIs file of the application, or nil if API authorization
+// is not supported by the target environment.
+func exportAuthorizedApis(appId string) ([]byte, error) {
+
+	authorizedApis, supported, err := getAuthorizedApis(appId)
+	if err != nil || !supported {
+		return nil, err
+	}
+
+	authorizedApiConfigs := []AuthorizedApiConfig{}
+	for _, api := range authorizedApis {
+		apiConfig := AuthorizedApiConfig{Identifier: api.Identifier, PolicyIdentifier: api.PolicyId, Scopes: []string{}}
+		for _, authorizedScope := range api.AuthorizedScopes {
+			apiConfig.Scopes = append(apiConfig.Scopes, authorizedScope.Name)
+		}
+		authorizedApiConfigs = append(authorizedApiConfigs, apiConfig)
+	}
+	return yaml.Marshal(authorizedApiConfigs)
+}
+
+func getAuthorizedApisFileName(appFileName string) string {
+
+	return utils.GetFileInfo(appFileName).ResourceName + AUTHORIZED_APIS_FILE_SUFFIX
+}
+
+// importAuthorizedApis updates the authorized APIs of the application according to the authorized APIs file of the
+// application, if the file exists.
+func importAuthorizedApis(handler utils.ResourceHandler, appId string, appFilePath string) error {
+
+	fileName := getAuthorizedApisFileName(appFilePath)
+	if _, err := os.Stat(filepath.Join(filepath.Dir(appFilePath), fileName)); os.IsNotExist(err) {
+		return nil
+	}
+	if appId == "" {
+		return fmt.Errorf("authorized APIs are not updated since the application ID is not returned")
+	}
+	fileData, err := utils.ReadAttachment(handler, appFilePath, fileName)
+	if err != nil {
+		return err
+	}
+	var localApis []AuthorizedApiConfig
+	if err := yaml.Unmarshal([]byte(fileData), &localApis); err != nil {
+		return fmt.Errorf("invalid file content for authorized APIs: %s", err)
+	}
+
+	deployedApis, _, err := getAuthorizedApis(appId)
+	if err != nil {
+		return err
+	}
+	deployedApiMap := make(map[string]authorizedApi)
+	for _, deployedApi := range deployedApis {
+		deployedApiMap[deployedApi.Id] = deployedApi
+	}
+
+	localApiIds := make(map[string]bool)
+	for _, localApi := range localApis {
+		apiId, err := getApiResourceId(localApi.Identifier)
+		if err != nil {
+			return err
+		}
+		localApiIds[apiId] = true
+		if err := syncAuthorizedApi(appId, apiId, localApi, deployedApiMap); err != nil {
+			return fmt.Errorf("error when authorizing the API: %s. %s", localApi.Identifier, err)
+		}
+	}
+	for _, deployedApi := range deployedApis {
+		if !localApiIds[deployedApi.Id] {
+			_, _, err := utils.SendJsonRequest(http.MethodDelete, getAuthorizedApiUrl(appId, deployedApi.Id), nil, utils.APPLICATIONS)
+			if err != nil {
+				return fmt.Errorf("error when removing the authorized API: %s. %s", deployedApi.Identifier, err)
+			}
+		}
+	}
+	return nil
+}
+
+func syncAuthorizedApi(appId string, apiId string, localApi AuthorizedApiConfig, deployedApis map[string]authorizedApi) error {
+
+	if localApi.PolicyIdentifier == "" {
+		localApi.PolicyIdentifier = DEFAULT_AUTHORIZATION_POLICY
+	}
+	if localApi.Scopes == nil {
+		localApi.Scopes = []string{}
+	}
+
+	deployedApi, ok := deployedApis[apiId]
+	if ok && deployedApi.PolicyId != localApi.PolicyIdentifier {
+		// The authorization policy cannot be updated, hence the API is authorized again with the new policy.
+		if _, _, err := utils.SendJsonRequest(http.MethodDelete, getAuthorizedApiUrl(appId, apiId), nil, utils.APPLICATIONS); err != nil {
+			return err
+		}
+		ok = false
+	}
+	if !ok {
+		body := map[string]interface{}{
+			"id":               apiId,
+			"policyIdentifier": localApi.PolicyIdentifier,
+			"scopes":           localApi.Scopes,
+		}
+		_, _, err := utils.SendJsonRequest(http.MethodPost, getAuthorizedApiUrl(appId, ""), body, utils.APPLICATIONS)
+		return err
+	}
+
+	var deployedScopes []string
+	for _, authorizedScope := range deployedApi.AuthorizedScopes {
+		deployedScopes = append(deployedScopes, authorizedScope.Name)
+	}
+	addedScopes, removedScopes := []string{}, []string{}
+	for _, scope := range localApi.Scopes {
+		if !utils.Contains(deployedScopes, scope) {
+			addedScopes = append(addedScopes, scope)
+		}
+	}
+	for _, scope := range deployedScopes {
+		if !utils.Contains(localApi.Scopes, scope) {
+			removedScopes = append(removedScopes, scope)
+		}
+	}
+	if len(addedScopes) == 0 && len(removedScopes) == 0 {
+		return nil
+	}
+	body := map[string]interface{}{"addedScopes": addedScopes, "removedScopes": removedScopes}
+	_, _, err := utils.SendJsonRequest(http.MethodPatch, getAuthorizedApiUrl(appId, apiId), body, utils.APPLICATIONS)
+	return err
+}
+
+// getApiResourceId returns the ID of the API resource with the given identifier in the target environment. The
+// business APIs are resolved from the inventory of the deployed API resources, and the other APIs, such as the system
+// APIs, are retrieved by the identifier.
+func getApiResourceId(identifier string) (string, error) {
+
+	if apiResourceHandler := utils.GetResourceHandler(utils.API_RESOURCES); apiResourceHandler != nil {
+		inventory, err := utils.GetInventory(apiResourceHandler)
+		if err != nil {
+			return "", fmt.Errorf("error when retrieving the deployed API resources. %s", err)
+		}
+		if apiResource, ok := inventory.GetByName(identifier); ok {
+			return apiResource.Id, nil
+		}
+	}
+
+	query := url.Values{}
+	query.Set("filter", fmt.Sprintf("identifier eq %q", identifier))
+	apiResourcesUrl := utils.GetServerBaseUrl() + "/api/server/v1/api-resources?" + query.Encode()
+	body, _, err := utils.SendJsonRequest(http.MethodGet, apiResourcesUrl, nil, utils.APPLICATIONS)
+	if err != nil {
+		return "", fmt.Errorf("error when retrieving the API resource: %s. %s", identifier, err)
+	}
+	var list struct {
+		ApiResources []struct {
+			Id         string `json:"id"`
+			Identifier string `json:"identifier"`
+		} `json:"apiResources"`
+	}
+	if err := json.Unmarshal(body, &list); err != nil {
+		return "", fmt.Errorf("error when unmarshalling the retrieved API resource: %s. %s", identifier, err)
+	}
+	for _, apiResource := range list.ApiResources {
+		if apiResource.Identifier == identifier {
+			return apiResource.Id, nil
+		}
+	}
+	return "", fmt.Errorf("API resource: %s not found in the target environment", identifier)
+}
@@ -0,0 +1,213 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package applications
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strings"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+type applicationHandler struct{}
+
+func init() {
+
+	utils.RegisterResourceHandler(&applicationHandler{})
+}
+
+func (h *applicationHandler) GetResourceType() string {
+
+	return utils.APPLICATIONS
+}
+
+func (h *applicationHandler) GetConfigKey() string {
+
+	return utils.APPLICATIONS_CONFIG
+}
+
+func (h *applicationHandler) GetDependencies() []string {
+
+	return []string{utils.CLAIMS, utils.IDENTITY_PROVIDERS, utils.USERSTORES, utils.ROLES, utils.OIDC_SCOPES,
+		utils.API_RESOURCES}
+}
+
+func (h *applicationHandler) GetArrayIdentifiers() map[string]string {
+
+	return utils.GetArrayIdentifiers(utils.APPLICATIONS)
+}
+
+func (h *applicationHandler) GetDeployedResources() ([]utils.Resource, error) {
+
+	apps, err := getAppList()
+	if err != nil {
+		return nil, err
+	}
+	var sharedAppIds map[string]bool
+	if utils.IsOrganizationContext() && len(apps) > 0 {
+		if sharedAppIds, err = getSharedAppIds(apps); err != nil {
+			return nil, err
+		}
+	}
+	var resources []utils.Resource
+	for _, app := range apps {
+		if sharedAppIds[app.Id] {
+			continue
+		}
+		resources = append(resources, utils.Resource{Id: app.Id, Name: app.Name})
+	}
+	return resources, nil
+}
+
+func (h *applicationHandler) GetFileName(resource utils.Resource) string {
+
+	return resource.Name
+}
+
+func (h *applicationHandler) ExportResource(resource utils.Resource, format string, excludeSecrets bool) (string, []byte, error) {
+
+	resp, err := utils.SendExportRequest(resource.Id, utils.GetFileType(format), utils.APPLICATIONS, excludeSecrets)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while exporting the application: %s", err)
+	}
+	defer resp.Body.Close()
+
+	fileName, err := utils.GetExportedFileName(resp)
+	if err != nil {
+		return "", nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while reading the response body when exporting app: %s. %s", fileName, err)
+	}
+
+	if excludeSecrets {
+		body = maskOAuthConsumerSecret(body)
+	}
+	return fileName, body, nil
+}
+
+func (h *applicationHandler) ResolveLocalResource(fileData []byte, fileInfo utils.FileInfo,
+	inventory *utils.ResourceInventory) (utils.Resource, error) {
+
+	// Validate the YAML format.
+	var appConfig AppConfig
+	err := yaml.Unmarshal(fileData, &appConfig)
+	if err != nil {
+		return utils.Resource{}, fmt.Errorf("invalid file content for app: %s. %s", fileInfo.ResourceName, err)
+	}
+	if appConfig.ApplicationName != fileInfo.ResourceName {
+		log.Println("Warning: Application name in the file " + fileInfo.FileName + " is not matching with the file name.")
+	}
+
+	resource := utils.Resource{Name: appConfig.ApplicationName}
+	if app, ok := inventory.GetByName(appConfig.ApplicationName); ok {
+		resource.Id = app.Id
+	}
+	return resource, nil
+}
+
+func (h *applicationHandler) ImportResource(resource utils.Resource, filePath string, fileData string) (string, error) {
+
+	modifiedFileData := utils.RemoveSecretMasks(fileData)
+	appId, err := utils.SendImportRequest(filePath, modifiedFileData, utils.APPLICATIONS)
+	if err != nil {
+		return "", err
+	}
+	if err := importAppAttachments(h, appId, filePath); err != nil {
+		return appId, err
+	}
+
+	if oauthApp, err := isOauthApp(modifiedFileData); err != nil {
+		fmt.Println("Failed to check if the applications is an OAuth app:", err.Error())
+	} else if oauthSecretGiven, err := isOauthSecretGiven(modifiedFileData); err != nil {
+		fmt.Println("Failed to check if oauthConsumerSecret is given:", err.Error())
+	} else if oauthApp && !oauthSecretGiven {
+		// Check if oauthConsumerSecret is given or else add an indicator to the summary informing a new secret is generated.
+		utils.AddNewSecretIndicatorToSummary(resource.Name)
+	}
+	return appId, nil
+}
+
+func (h *applicationHandler) UpdateResource(resource utils.Resource, filePath string, fileData string) error {
+
+	err := utils.SendUpdateRequest("", filePath, utils.RemoveSecretMasks(fileData), utils.APPLICATIONS)
+	if err != nil {
+		return err
+	}
+	return importAppAttachments(h, resource.Id, filePath)
+}
+
+// ExtractAttachments returns the APIs authorized for the application and the organizations the application is shared
+// with, which are stored in separate files since they are not included in the exported application.
+func (h *applicationHandler) ExtractAttachments(resource utils.Resource, fileName string,
+	content []byte) ([]byte, map[string][]byte, error) {
+
+	attachments := make(map[string][]byte)
+	authorizedApis, err := exportAuthorizedApis(resource.Id)
+	if err != nil {
+		return content, nil, err
+	}
+	if authorizedApis != nil {
+		attachments[getAuthorizedApisFileName(fileName)] = authorizedApis
+	}
+	sharedOrganizations, err := exportSharedOrganizations(resource.Id)
+	if err != nil {
+		return content, nil, err
+	}
+	if sharedOrganizations != nil {
+		attachments[getSharedOrganizationsFileName(fileName)] = sharedOrganizations
+	}
+	return content, attachments, nil
+}
+
+func (h *applicationHandler) IsAttachment(fileName string) bool {
+
+	return strings.HasSuffix(fileName, AUTHORIZED_APIS_FILE_SUFFIX) || strings.HasSuffix(fileName, SHARED_ORGANIZATIONS_FILE_SUFFIX)
+}
+
+func importAppAttachments(handler utils.ResourceHandler, appId string, filePath string) error {
+
+	if err := importAuthorizedApis(handler, appId, filePath); err != nil {
+		return err
+	}
+	return importSharedOrganizations(handler, appId, filePath)
+}
+
+func (h *applicationHandler) DeleteResource(resource utils.Resource) error {
+
+	return utils.SendDeleteRequest(resource.Id, utils.APPLICATIONS)
+}
+
+func (h *applicationHandler) IsDeletable(resource utils.Resource) bool {
+
+	if resource.Name == utils.CONSOLE || resource.Name == utils.MY_ACCOUNT {
+		return false
+	}
+	isToolManagementApp, err := isToolMgtApp(resource)
+	if err != nil {
+		log.Printf("Error checking if application is a tool management app: %s\n", err.Error())
+		return false
+	}
+	return !isToolManagementApp
+}
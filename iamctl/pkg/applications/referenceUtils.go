@@ -0,0 +1,94 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package applications
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+const ORGANIZATION_AUDIENCE = "organization"
+
+type identityProviderReference struct {
+	IdentityProviderName string `yaml:"identityProviderName"`
+}
+
+// appReferenceConfig contains the fields of an application that refer to resources of other types.
+type appReferenceConfig struct {
+	ClaimConfig struct {
+		ClaimMappings []struct {
+			LocalClaim struct {
+				ClaimUri string `yaml:"claimUri"`
+			} `yaml:"localClaim"`
+		} `yaml:"claimMappings"`
+	} `yaml:"claimConfig"`
+	LocalAndOutBoundAuthenticationConfig struct {
+		AuthenticationSteps []struct {
+			FederatedIdentityProviders []identityProviderReference `yaml:"federatedIdentityProviders"`
+		} `yaml:"authenticationSteps"`
+	} `yaml:"localAndOutBoundAuthenticationConfig"`
+	OutboundProvisioningConfig struct {
+		ProvisioningIdentityProviders []identityProviderReference `yaml:"provisioningIdentityProviders"`
+	} `yaml:"outboundProvisioningConfig"`
+	InboundProvisioningConfig struct {
+		ProvisioningUserStore string `yaml:"provisioningUserStore"`
+	} `yaml:"inboundProvisioningConfig"`
+	AssociatedRolesConfig struct {
+		AllowedAudience string `yaml:"allowedAudience"`
+		Roles           []struct {
+			Name string `yaml:"name"`
+		} `yaml:"roles"`
+	} `yaml:"associatedRolesConfig"`
+}
+
+// GetReferences returns the identity providers, claims, roles and user stores referred to in the application file.
+func (h *applicationHandler) GetReferences(fileData []byte) (map[string][]string, error) {
+
+	var appConfig appReferenceConfig
+	if err := yaml.Unmarshal(fileData, &appConfig); err != nil {
+		return nil, fmt.Errorf("invalid file content for app. %s", err)
+	}
+
+	references := make(map[string][]string)
+	for _, claimMapping := range appConfig.ClaimConfig.ClaimMappings {
+		references[utils.CLAIMS] = append(references[utils.CLAIMS], claimMapping.LocalClaim.ClaimUri)
+	}
+	for _, step := range appConfig.LocalAndOutBoundAuthenticationConfig.AuthenticationSteps {
+		for _, idp := range step.FederatedIdentityProviders {
+			references[utils.IDENTITY_PROVIDERS] = append(references[utils.IDENTITY_PROVIDERS], idp.IdentityProviderName)
+		}
+	}
+	for _, idp := range appConfig.OutboundProvisioningConfig.ProvisioningIdentityProviders {
+		references[utils.IDENTITY_PROVIDERS] = append(references[utils.IDENTITY_PROVIDERS], idp.IdentityProviderName)
+	}
+	if userStore := appConfig.InboundProvisioningConfig.ProvisioningUserStore; userStore != "" {
+		references[utils.USERSTORES] = append(references[utils.USERSTORES], userStore)
+	}
+
+	// Roles of the application audience are created along with the application.
+	if strings.EqualFold(appConfig.AssociatedRolesConfig.AllowedAudience, ORGANIZATION_AUDIENCE) {
+		for _, role := range appConfig.AssociatedRolesConfig.Roles {
+			references[utils.ROLES] = append(references[utils.ROLES], role.Name)
+		}
+	}
+	return references, nil
+}
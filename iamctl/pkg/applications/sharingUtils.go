@@ -0,0 +1,227 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package applications
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+const SHARED_ORGANIZATIONS_FILE_SUFFIX = ".shared-organizations.yml"
+
+// SharingConfig is the format of the shared organizations file of an application.
+type SharingConfig struct {
+	SharedOrganizations []string `yaml:"sharedOrganizations"`
+}
+
+// getSharedOrganizations returns the organizations the application is shared with. The returned flag is false if
+// application sharing is not supported by the target environment.
+func getSharedOrganizations(appId string) ([]utils.Organization, bool, error) {
+
+	body, statusCode, err := utils.SendJsonRequest(http.MethodGet, getSharedOrganizationsUrl(appId, ""), nil, utils.APPLICATIONS)
+	if statusCode == http.StatusNotFound {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("error when retrieving the shared organizations. %s", err)
+	}
+	var list struct {
+		Organizations []utils.Organization `json:"organizations"`
+	}
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, false, fmt.Errorf("error when unmarshalling the shared organizations. %s", err)
+	}
+	return list.Organizations, true, nil
+}
+
+func getSharedOrganizationsUrl(appId string, organizationId string) string {
+
+	sharedOrganizationsUrl := utils.GetServerBaseUrl() + "/api/server/v1/applications/" + appId + "/shared-organizations"
+	if organizationId == "" {
+		return sharedOrganizationsUrl
+	}
+	return sharedOrganizationsUrl + "/" + organizationId
+}
+
+// exportSharedOrganizations returns the content of the shared organizations file of the application, or nil if
+// application sharing is not supported by the target environment.
+func exportSharedOrganizations(appId string) ([]byte, error) {
+
+	organizations, supported, err := getSharedOrganizations(appId)
+	if err != nil || !supported {
+		return nil, err
+	}
+
+	sharingConfig := SharingConfig{SharedOrganizations: []string{}}
+	for _, organization := range organizations {
+		sharingConfig.SharedOrganizations = append(sharingConfig.SharedOrganizations, organization.Name)
+	}
+	return yaml.Marshal(sharingConfig)
+}
+
+func getSharedOrganizationsFileName(appFileName string) string {
+
+	return utils.GetFileInfo(appFileName).ResourceName + SHARED_ORGANIZATIONS_FILE_SUFFIX
+}
+
+// importSharedOrganizations shares the application with the organizations in the shared organizations file of the
+// application, and stops sharing it with the rest, if the file exists.
+func importSharedOrganizations(handler utils.ResourceHandler, appId string, appFilePath string) error {
+
+	fileName := getSharedOrganizationsFileName(appFilePath)
+	if _, err := os.Stat(filepath.Join(filepath.Dir(appFilePath), fileName)); os.IsNotExist(err) {
+		return nil
+	}
+	if appId == "" {
+		return fmt.Errorf("shared organizations are not updated since the application ID is not returned")
+	}
+	fileData, err := utils.ReadAttachment(handler, appFilePath, fileName)
+	if err != nil {
+		return err
+	}
+	var sharingConfig SharingConfig
+	if err := yaml.Unmarshal([]byte(fileData), &sharingConfig); err != nil {
+		return fmt.Errorf("invalid file content for shared organizations: %s", err)
+	}
+
+	sharedOrganizations, supported, err := getSharedOrganizations(appId)
+	if err != nil {
+		return err
+	}
+	if !supported {
+		return fmt.Errorf("application sharing is not supported by the target environment")
+	}
+	subOrganizations, err := utils.GetSubOrganizations(true)
+	if err != nil {
+		return err
+	}
+
+	var sharedNames []string
+	for _, organization := range sharedOrganizations {
+		sharedNames = append(sharedNames, organization.Name)
+	}
+	addedIds := []string{}
+	for _, name := range sharingConfig.SharedOrganizations {
+		if utils.Contains(sharedNames, name) {
+			continue
+		}
+		organizationId := ""
+		for _, organization := range subOrganizations {
+			if organization.Name == name {
+				organizationId = organization.Id
+			}
+		}
+		if organizationId == "" {
+			return fmt.Errorf("organization: %s not found in the target environment", name)
+		}
+		addedIds = append(addedIds, organizationId)
+	}
+	if len(addedIds) > 0 {
+		body := map[string]interface{}{"shareWithAllChildren": false, "sharedOrganizations": addedIds}
+		shareUrl := utils.GetServerBaseUrl() + "/api/server/v1/applications/" + appId + "/share"
+		if _, _, err := utils.SendJsonRequest(http.MethodPost, shareUrl, body, utils.APPLICATIONS); err != nil {
+			return fmt.Errorf("error when sharing the application. %s", err)
+		}
+	}
+
+	for _, organization := range sharedOrganizations {
+		if utils.Contains(sharingConfig.SharedOrganizations, organization.Name) {
+			continue
+		}
+		_, _, err := utils.SendJsonRequest(http.MethodDelete, getSharedOrganizationsUrl(appId, organization.Id), nil, utils.APPLICATIONS)
+		if err != nil {
+			return fmt.Errorf("error when stopping sharing the application with the organization: %s. %s", organization.Name, err)
+		}
+	}
+	return nil
+}
+
+// getSharedAppIds returns the IDs of the given applications that are shared with the targeted organization by its parent
+// organization. The advanced configurations of the applications are retrieved in a single list request, and each
+// application is retrieved separately only if the list does not contain its advanced configurations.
+func getSharedAppIds(apps []Application) (map[string]bool, error) {
+
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(len(apps)))
+	query.Set("attributes", "advancedConfigurations")
+	appsUrl := utils.GetServerBaseUrl() + "/api/server/v1/applications?" + query.Encode()
+	body, _, err := utils.SendJsonRequest(http.MethodGet, appsUrl, nil, utils.APPLICATIONS)
+	if err != nil {
+		return nil, fmt.Errorf("error when retrieving the advanced configurations of the applications. %s", err)
+	}
+	var list struct {
+		Applications []struct {
+			Id                     string `json:"id"`
+			AdvancedConfigurations *struct {
+				Fragment bool `json:"fragment"`
+			} `json:"advancedConfigurations"`
+		} `json:"applications"`
+	}
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, fmt.Errorf("error when unmarshalling the advanced configurations of the applications. %s", err)
+	}
+	listedApps := make(map[string]bool)
+	sharedAppIds := make(map[string]bool)
+	for _, app := range list.Applications {
+		if app.AdvancedConfigurations != nil {
+			listedApps[app.Id] = true
+			sharedAppIds[app.Id] = app.AdvancedConfigurations.Fragment
+		}
+	}
+
+	for _, app := range apps {
+		if listedApps[app.Id] {
+			continue
+		}
+		sharedApp, err := isSharedApp(app.Id)
+		if err != nil {
+			return nil, err
+		}
+		sharedAppIds[app.Id] = sharedApp
+	}
+	return sharedAppIds, nil
+}
+
+// isSharedApp returns true if the application is shared with the targeted organization by its parent organization.
+// Shared applications are managed in the parent organization and are therefore not handled in the organization.
+func isSharedApp(appId string) (bool, error) {
+
+	body, _, err := utils.SendJsonRequest(http.MethodGet, utils.GetServerBaseUrl()+"/api/server/v1/applications/"+appId,
+		nil, utils.APPLICATIONS)
+	if err != nil {
+		return false, fmt.Errorf("error when retrieving the application. %s", err)
+	}
+	var app struct {
+		AdvancedConfigurations struct {
+			Fragment bool `json:"fragment"`
+		} `json:"advancedConfigurations"`
+	}
+	if err := json.Unmarshal(body, &app); err != nil {
+		return false, fmt.Errorf("error when unmarshalling the retrieved application. %s", err)
+	}
+	return app.AdvancedConfigurations.Fragment, nil
+}
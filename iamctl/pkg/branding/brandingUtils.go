@@ -0,0 +1,250 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package branding
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+const ORG_TYPE = "ORG"
+const APP_TYPE = "APP"
+
+var fileNameRegex = regexp.MustCompile(`[^\w\d-]+`)
+
+var defaultLocales = []string{"en-US"}
+var defaultScreens = []string{"common", "login"}
+
+// BrandingConfig is the format of the branding files, where each file contains the branding preference of the
+// organization or an application in a locale, along with the custom text of each screen.
+type BrandingConfig struct {
+	Type        string                            `yaml:"type"`
+	Application string                            `yaml:"application,omitempty"`
+	Locale      string                            `yaml:"locale"`
+	Preference  map[string]interface{}            `yaml:"preference"`
+	CustomText  map[string]map[string]interface{} `yaml:"customText,omitempty"`
+}
+
+type brandingResponse struct {
+	Preference map[string]interface{} `json:"preference"`
+}
+
+// brandingTarget identifies a branding preference of the organization or an application in a locale.
+type brandingTarget struct {
+	Type   string
+	Name   string
+	Locale string
+}
+
+// The resource ID of a branding preference is the type, the name of the tenant or the application ID and the locale,
+// separated by slashes.
+func (target brandingTarget) getResourceId() string {
+
+	return target.Type + "/" + target.Name + "/" + target.Locale
+}
+
+func getBrandingTarget(resourceId string) brandingTarget {
+
+	parts := strings.SplitN(resourceId, "/", 3)
+	return brandingTarget{Type: parts[0], Name: parts[1], Locale: parts[2]}
+}
+
+func getResourceName(brandingType string, application string, locale string) string {
+
+	if brandingType == APP_TYPE {
+		return APP_TYPE + "_" + application + "_" + locale
+	}
+	return ORG_TYPE + "_" + locale
+}
+
+func getBrandingUrl(target brandingTarget, screen string) string {
+
+	query := url.Values{}
+	query.Set("type", target.Type)
+	query.Set("name", target.Name)
+	query.Set("locale", target.Locale)
+	brandingUrl := utils.GetServerBaseUrl() + "/api/server/v1/branding-preference"
+	if screen == "" {
+		return brandingUrl + "?" + query.Encode()
+	}
+	query.Set("screen", screen)
+	return brandingUrl + "/text?" + query.Encode()
+}
+
+// getPreference returns the branding preference of the target, or nil if branding is not configured for the target.
+func getPreference(target brandingTarget, screen string) (map[string]interface{}, error) {
+
+	body, statusCode, err := utils.SendJsonRequest(http.MethodGet, getBrandingUrl(target, screen), nil, utils.BRANDING)
+	if statusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var response brandingResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("error when unmarshalling the retrieved branding preference. %w", err)
+	}
+	return response.Preference, nil
+}
+
+// savePreference creates or updates the branding preference or the custom text of a screen of the target.
+func savePreference(target brandingTarget, screen string, preference map[string]interface{}, exists bool) error {
+
+	body := map[string]interface{}{
+		"type":       target.Type,
+		"name":       target.Name,
+		"locale":     target.Locale,
+		"preference": preference,
+	}
+	if screen != "" {
+		body["screen"] = screen
+	}
+	requestUrl := utils.GetServerBaseUrl() + "/api/server/v1/branding-preference"
+	if screen != "" {
+		requestUrl += "/text"
+	}
+	method := http.MethodPost
+	if exists {
+		method = http.MethodPut
+	}
+	_, _, err := utils.SendJsonRequest(method, requestUrl, body, utils.BRANDING)
+	return err
+}
+
+// syncCustomText updates the custom text of the screens defined in the file and removes the custom text of the other
+// screens managed by the tool.
+func syncCustomText(target brandingTarget, customText map[string]map[string]interface{}, screens []string) error {
+
+	for _, screen := range screens {
+		deployedText, err := getPreference(target, screen)
+		if err != nil {
+			return fmt.Errorf("error when retrieving the custom text of screen: %s. %s", screen, err)
+		}
+		text, ok := customText[screen]
+		if ok {
+			err = savePreference(target, screen, text, deployedText != nil)
+		} else if deployedText != nil {
+			_, _, err = utils.SendJsonRequest(http.MethodDelete, getBrandingUrl(target, screen), nil, utils.BRANDING)
+		}
+		if err != nil {
+			return fmt.Errorf("error when updating the custom text of screen: %s. %s", screen, err)
+		}
+	}
+	return nil
+}
+
+// getTargetName returns the name used by the branding API for the organization or the application of the file.
+func getTargetName(config BrandingConfig) (string, error) {
+
+	if config.Type != APP_TYPE {
+		return utils.SERVER_CONFIGS.TenantDomain, nil
+	}
+	app, err := getApplication(config.Application)
+	if err != nil {
+		return "", err
+	}
+	return app.Id, nil
+}
+
+// getDeployedResourceId returns the resource ID of the branding preference of the file, or an empty ID if the
+// application or its branding preference does not exist in the target environment.
+func getDeployedResourceId(config BrandingConfig) (string, error) {
+
+	appInventory, err := getApplicationInventory()
+	if err != nil {
+		return "", err
+	}
+	app, ok := appInventory.GetByName(config.Application)
+	if !ok {
+		return "", nil
+	}
+	target := brandingTarget{Type: APP_TYPE, Name: app.Id, Locale: config.Locale}
+	preference, err := getPreference(target, "")
+	if err != nil || preference == nil {
+		return "", err
+	}
+	return target.getResourceId(), nil
+}
+
+func getApplicationInventory() (*utils.ResourceInventory, error) {
+
+	applicationHandler := utils.GetResourceHandler(utils.APPLICATIONS)
+	if applicationHandler == nil {
+		return nil, fmt.Errorf("applications are not supported by the tool")
+	}
+	appInventory, err := utils.GetInventory(applicationHandler)
+	if err != nil {
+		return nil, fmt.Errorf("error when retrieving the deployed applications. %s", err)
+	}
+	return appInventory, nil
+}
+
+func getApplication(appName string) (utils.Resource, error) {
+
+	appInventory, err := getApplicationInventory()
+	if err != nil {
+		return utils.Resource{}, err
+	}
+	app, ok := appInventory.GetByName(appName)
+	if !ok {
+		return utils.Resource{}, fmt.Errorf("application: %s not found in the target environment", appName)
+	}
+	return app, nil
+}
+
+func getApplicationById(appId string) (utils.Resource, error) {
+
+	appInventory, err := getApplicationInventory()
+	if err != nil {
+		return utils.Resource{}, err
+	}
+	app, ok := appInventory.GetById(appId)
+	if !ok {
+		return utils.Resource{}, fmt.Errorf("application with ID: %s not found in the target environment", appId)
+	}
+	return app, nil
+}
+
+// getConfiguredList returns the values of a list config of the branding tool configs, or the default values.
+func getConfiguredList(resourceConfigs map[string]interface{}, configKey string, defaultValues []string) []string {
+
+	configuredValues, ok := resourceConfigs[configKey].([]interface{})
+	if !ok {
+		return defaultValues
+	}
+	var values []string
+	for _, value := range configuredValues {
+		if value, ok := value.(string); ok {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func formatFileName(resourceName string) string {
+
+	return fileNameRegex.ReplaceAllString(resourceName, "_")
+}
@@ -0,0 +1,245 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package branding
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+type brandingHandler struct{}
+
+func init() {
+
+	utils.RegisterResourceHandler(&brandingHandler{})
+}
+
+func (h *brandingHandler) GetResourceType() string {
+
+	return utils.BRANDING
+}
+
+func (h *brandingHandler) GetConfigKey() string {
+
+	return utils.BRANDING_CONFIG
+}
+
+func (h *brandingHandler) GetDependencies() []string {
+
+	return []string{utils.APPLICATIONS}
+}
+
+func (h *brandingHandler) GetArrayIdentifiers() map[string]string {
+
+	return map[string]string{}
+}
+
+// GetDeployedResources returns the branding preferences of the organization and the applications in the locales
+// configured in the tool configs, since the branding preferences cannot be listed. The branding preferences of the
+// applications are looked up for each application, hence they are only listed when all the deployed resources are
+// needed in the run, and are otherwise resolved for the local files.
+func (h *brandingHandler) GetDeployedResources() ([]utils.Resource, error) {
+
+	// The organization branding is identified by the tenant domain, and application branding by the application ID.
+	targets := []brandingTarget{{Type: ORG_TYPE, Name: utils.SERVER_CONFIGS.TenantDomain}}
+	targetNames := []string{""}
+	if utils.IsDeployedResourceListRequired() {
+		appInventory, err := getApplicationInventory()
+		if err != nil {
+			return nil, err
+		}
+		for _, app := range appInventory.List() {
+			targets = append(targets, brandingTarget{Type: APP_TYPE, Name: app.Id})
+			targetNames = append(targetNames, app.Name)
+		}
+	}
+
+	var resources []utils.Resource
+	locales := getConfiguredList(utils.GetResourceToolConfigs(h), utils.LOCALES_CONFIG, defaultLocales)
+	for i, target := range targets {
+		for _, locale := range locales {
+			target.Locale = locale
+			resourceName := getResourceName(target.Type, targetNames[i], locale)
+			preference, err := getPreference(target, "")
+			if err != nil {
+				return nil, fmt.Errorf("error when retrieving the branding preference: %s. %s", resourceName, err)
+			}
+			if preference != nil {
+				resources = append(resources, utils.Resource{Id: target.getResourceId(), Name: resourceName})
+			}
+		}
+	}
+	return resources, nil
+}
+
+func (h *brandingHandler) GetFileName(resource utils.Resource) string {
+
+	return formatFileName(resource.Name)
+}
+
+func (h *brandingHandler) ExportResource(resource utils.Resource, format string, excludeSecrets bool) (string, []byte, error) {
+
+	target := getBrandingTarget(resource.Id)
+	preference, err := getPreference(target, "")
+	if err != nil {
+		return "", nil, fmt.Errorf("error while exporting the branding preference: %s", err)
+	}
+
+	config := BrandingConfig{
+		Type:       target.Type,
+		Locale:     target.Locale,
+		Preference: preference,
+		CustomText: map[string]map[string]interface{}{},
+	}
+	if target.Type == APP_TYPE {
+		app, err := getApplicationById(target.Name)
+		if err != nil {
+			return "", nil, err
+		}
+		config.Application = app.Name
+	}
+	screens := getConfiguredList(utils.GetResourceToolConfigs(h), utils.CUSTOM_TEXT_SCREENS_CONFIG, defaultScreens)
+	for _, screen := range screens {
+		text, err := getPreference(target, screen)
+		if err != nil {
+			return "", nil, fmt.Errorf("error while exporting the custom text of screen: %s. %s", screen, err)
+		}
+		if text != nil {
+			config.CustomText[screen] = text
+		}
+	}
+
+	content, err := yaml.Marshal(config)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while converting the exported branding preference: %s", err)
+	}
+	return formatFileName(resource.Name) + ".yml", content, nil
+}
+
+func (h *brandingHandler) ResolveLocalResource(fileData []byte, fileInfo utils.FileInfo,
+	inventory *utils.ResourceInventory) (utils.Resource, error) {
+
+	config, err := parseBrandingConfig(fileData)
+	if err != nil {
+		return utils.Resource{}, fmt.Errorf("invalid file content for branding: %s. %s", fileInfo.ResourceName, err)
+	}
+
+	resource := utils.Resource{Name: getResourceName(config.Type, config.Application, config.Locale)}
+	if deployedResource, ok := inventory.GetByName(resource.Name); ok {
+		resource.Id = deployedResource.Id
+	} else if config.Type == APP_TYPE && !utils.IsDeployedResourceListRequired() {
+		// Application branding is not listed in the inventory, hence look up the branding of the application.
+		if resource.Id, err = getDeployedResourceId(config); err != nil {
+			return utils.Resource{}, fmt.Errorf("error when retrieving the branding preference: %s. %s", resource.Name, err)
+		}
+		if resource.Id != "" {
+			inventory.Put(resource)
+		}
+	}
+	return resource, nil
+}
+
+func (h *brandingHandler) ImportResource(resource utils.Resource, filePath string, fileData string) (string, error) {
+
+	config, err := parseBrandingConfig([]byte(fileData))
+	if err != nil {
+		return "", fmt.Errorf("invalid file content for branding: %s", err)
+	}
+	targetName, err := getTargetName(config)
+	if err != nil {
+		return "", err
+	}
+
+	target := brandingTarget{Type: config.Type, Name: targetName, Locale: config.Locale}
+	if err := savePreference(target, "", config.Preference, false); err != nil {
+		return "", err
+	}
+	screens := getConfiguredList(utils.GetResourceToolConfigs(h), utils.CUSTOM_TEXT_SCREENS_CONFIG, defaultScreens)
+	if err := syncCustomText(target, config.CustomText, screens); err != nil {
+		return target.getResourceId(), err
+	}
+	return target.getResourceId(), nil
+}
+
+func (h *brandingHandler) UpdateResource(resource utils.Resource, filePath string, fileData string) error {
+
+	config, err := parseBrandingConfig([]byte(fileData))
+	if err != nil {
+		return fmt.Errorf("invalid file content for branding: %s", err)
+	}
+
+	target := getBrandingTarget(resource.Id)
+	if err := savePreference(target, "", config.Preference, true); err != nil {
+		return err
+	}
+	screens := getConfiguredList(utils.GetResourceToolConfigs(h), utils.CUSTOM_TEXT_SCREENS_CONFIG, defaultScreens)
+	return syncCustomText(target, config.CustomText, screens)
+}
+
+func (h *brandingHandler) DeleteResource(resource utils.Resource) error {
+
+	target := getBrandingTarget(resource.Id)
+	screens := getConfiguredList(utils.GetResourceToolConfigs(h), utils.CUSTOM_TEXT_SCREENS_CONFIG, defaultScreens)
+	if err := syncCustomText(target, nil, screens); err != nil {
+		return err
+	}
+	_, _, err := utils.SendJsonRequest(http.MethodDelete, getBrandingUrl(target, ""), nil, utils.BRANDING)
+	return err
+}
+
+func (h *brandingHandler) IsDeletable(resource utils.Resource) bool {
+
+	return true
+}
+
+func parseBrandingConfig(fileData []byte) (BrandingConfig, error) {
+
+	var config BrandingConfig
+	if err := yaml.Unmarshal(fileData, &config); err != nil {
+		return config, err
+	}
+	if config.Type != ORG_TYPE && config.Type != APP_TYPE {
+		return config, fmt.Errorf("type should be %s or %s", ORG_TYPE, APP_TYPE)
+	}
+	if config.Type == APP_TYPE && config.Application == "" {
+		return config, fmt.Errorf("application is not defined for the application branding")
+	}
+	if config.Locale == "" {
+		return config, fmt.Errorf("locale is not defined")
+	}
+
+	// Convert the nested YAML maps to JSON compatible maps.
+	configMap, err := utils.YamlToMap(fileData)
+	if err != nil {
+		return config, err
+	}
+	config.Preference, _ = configMap["preference"].(map[string]interface{})
+	config.CustomText = map[string]map[string]interface{}{}
+	if customText, ok := configMap["customText"].(map[string]interface{}); ok {
+		for screen, text := range customText {
+			if text, ok := text.(map[string]interface{}); ok {
+				config.CustomText[screen] = text
+			}
+		}
+	}
+	return config, nil
+}
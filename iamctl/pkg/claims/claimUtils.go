@@ -22,10 +22,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"regexp"
 
 	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
-	"gopkg.in/yaml.v2"
 )
 
 type claimDialect struct {
@@ -34,8 +34,11 @@ type claimDialect struct {
 }
 
 type ClaimDialectConfigurations struct {
-	URI string `yaml:"dialectURI"`
-	ID  string `yaml:"id"`
+	URI    string `yaml:"dialectURI"`
+	ID     string `yaml:"id"`
+	Claims []struct {
+		ClaimURI string `yaml:"claimURI"`
+	} `yaml:"claims"`
 }
 
 func getClaimDialectsList() ([]claimDialect, error) {
@@ -67,27 +70,25 @@ func getClaimDialectsList() ([]claimDialect, error) {
 	return nil, fmt.Errorf("unexpected error while retrieving claim dialect list")
 }
 
-func getClaimKeywordMapping(claimDialectName string) map[string]interface{} {
+// getLocalClaimURIs returns the URIs of the claims of the local claim dialect in the target environment.
+func getLocalClaimURIs() ([]string, error) {
 
-	if utils.KEYWORD_CONFIGS.ClaimConfigs != nil {
-		return utils.ResolveAdvancedKeywordMapping(claimDialectName, utils.KEYWORD_CONFIGS.ClaimConfigs)
-	}
-	return utils.KEYWORD_CONFIGS.KeywordMappings
-}
-
-func getDeployedClaimDialectNames() []string {
-
-	claimDialects, err := getClaimDialectsList()
+	claimsUrl := utils.GetServerBaseUrl() + "/api/server/v1/claim-dialects/" + LOCAL_CLAIM_DIALECT_ID + "/claims"
+	body, _, err := utils.SendJsonRequest(http.MethodGet, claimsUrl, nil, utils.CLAIMS)
 	if err != nil {
-		return []string{}
+		return nil, fmt.Errorf("error while retrieving local claim list. %w", err)
 	}
-
-	var claimDialectNames []string
-	for _, claimDialect := range claimDialects {
-		formattedName := formatFileName(claimDialect.DialectURI)
-		claimDialectNames = append(claimDialectNames, formattedName)
+	var claims []struct {
+		ClaimURI string `json:"claimURI"`
+	}
+	if err := json.Unmarshal(body, &claims); err != nil {
+		return nil, fmt.Errorf("error when unmarshalling the retrieved local claim list. %w", err)
 	}
-	return claimDialectNames
+	var claimURIs []string
+	for _, claim := range claims {
+		claimURIs = append(claimURIs, claim.ClaimURI)
+	}
+	return claimURIs, nil
 }
 
 func formatFileName(fileName string) string {
@@ -98,30 +99,3 @@ func formatFileName(fileName string) string {
 	}
 	return formattedFileName
 }
-
-func getClaimDialectId(claimDialectFilePath string) (string, error) {
-
-	fileContent, err := ioutil.ReadFile(claimDialectFilePath)
-	if err != nil {
-		return "", fmt.Errorf("error when reading the file: %s. %s", claimDialectFilePath, err)
-	}
-
-	var claimDialectConfig ClaimDialectConfigurations
-	err = yaml.Unmarshal(fileContent, &claimDialectConfig)
-	if err != nil {
-		return "", fmt.Errorf("invalid file content at: %s. %s", claimDialectFilePath, err)
-	}
-
-	existingClaimDialectList, err := getClaimDialectsList()
-	if err != nil {
-		return "", fmt.Errorf("error when retrieving the deployed claim dialect list: %s", err)
-	}
-
-	for _, dialect := range existingClaimDialectList {
-		if dialect.Id == claimDialectConfig.ID {
-			return dialect.Id, nil
-		}
-	}
-	// Claim dialect does not exist, returning an empty user ID
-	return "", nil
-}
@@ -0,0 +1,163 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package claims
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+const LOCAL_CLAIM_DIALECT_FILE = "http_wso2_org_claims.yml"
+const LOCAL_CLAIM_DIALECT_URI = "http://wso2.org/claims"
+const LOCAL_CLAIM_DIALECT_ID = "local"
+
+type claimDialectHandler struct{}
+
+func init() {
+
+	utils.RegisterResourceHandler(&claimDialectHandler{})
+}
+
+func (h *claimDialectHandler) GetResourceType() string {
+
+	return utils.CLAIMS
+}
+
+func (h *claimDialectHandler) GetConfigKey() string {
+
+	return utils.CLAIM_CONFIG
+}
+
+func (h *claimDialectHandler) GetDependencies() []string {
+
+	return nil
+}
+
+func (h *claimDialectHandler) GetArrayIdentifiers() map[string]string {
+
+	return utils.GetArrayIdentifiers(utils.CLAIMS)
+}
+
+func (h *claimDialectHandler) GetDeployedResources() ([]utils.Resource, error) {
+
+	claimDialects, err := getClaimDialectsList()
+	if err != nil {
+		return nil, err
+	}
+	var resources []utils.Resource
+	for _, dialect := range claimDialects {
+		resources = append(resources, utils.Resource{Id: dialect.Id, Name: dialect.DialectURI})
+	}
+	return resources, nil
+}
+
+func (h *claimDialectHandler) GetFileName(resource utils.Resource) string {
+
+	return formatFileName(resource.Name)
+}
+
+func (h *claimDialectHandler) ExportResource(resource utils.Resource, format string, excludeSecrets bool) (string, []byte, error) {
+
+	resp, err := utils.SendExportRequest(resource.Id, utils.GetFileType(format), utils.CLAIMS, true)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while exporting the claim dialect: %s", err)
+	}
+	defer resp.Body.Close()
+
+	fileName, err := utils.GetExportedFileName(resp)
+	if err != nil {
+		return "", nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while reading the response body when exporting claim dialect: %s. %s", fileName, err)
+	}
+	return fileName, body, nil
+}
+
+func (h *claimDialectHandler) ResolveLocalResource(fileData []byte, fileInfo utils.FileInfo,
+	inventory *utils.ResourceInventory) (utils.Resource, error) {
+
+	// Unmarshal the file data to get the dialect URI as the resource name.
+	var claimDialectConfig ClaimDialectConfigurations
+	err := yaml.Unmarshal(fileData, &claimDialectConfig)
+	if err != nil {
+		return utils.Resource{}, fmt.Errorf("invalid file content for claim dialect: %s. %s", fileInfo.ResourceName, err)
+	}
+
+	resource := utils.Resource{Name: claimDialectConfig.URI}
+	if dialect, ok := inventory.GetById(claimDialectConfig.ID); ok {
+		resource.Id = dialect.Id
+	}
+	return resource, nil
+}
+
+func (h *claimDialectHandler) ImportResource(resource utils.Resource, filePath string, fileData string) (string, error) {
+
+	return utils.SendImportRequest(filePath, fileData, utils.CLAIMS)
+}
+
+func (h *claimDialectHandler) UpdateResource(resource utils.Resource, filePath string, fileData string) error {
+
+	return utils.SendUpdateRequest(resource.Id, filePath, fileData, utils.CLAIMS)
+}
+
+func (h *claimDialectHandler) DeleteResource(resource utils.Resource) error {
+
+	return utils.SendDeleteRequest(resource.Id, utils.CLAIMS)
+}
+
+func (h *claimDialectHandler) IsDeletable(resource utils.Resource) bool {
+
+	return true
+}
+
+func (h *claimDialectHandler) IsImportedFirst(fileName string) bool {
+
+	// Import the local claims first, since the claims of other dialects are mapped to them.
+	return fileName == LOCAL_CLAIM_DIALECT_FILE
+}
+
+// GetLocalReferenceNames returns the URIs of the claims in the local file, if it is the local claim dialect. Claims of
+// other dialects are not referred to by other resources.
+func (h *claimDialectHandler) GetLocalReferenceNames(fileData []byte) ([]string, error) {
+
+	var claimDialectConfig ClaimDialectConfigurations
+	if err := yaml.Unmarshal(fileData, &claimDialectConfig); err != nil {
+		return nil, fmt.Errorf("invalid file content for claim dialect. %s", err)
+	}
+	if claimDialectConfig.URI != LOCAL_CLAIM_DIALECT_URI {
+		return nil, nil
+	}
+	var claimURIs []string
+	for _, claim := range claimDialectConfig.Claims {
+		claimURIs = append(claimURIs, claim.ClaimURI)
+	}
+	return claimURIs, nil
+}
+
+// GetDeployedReferenceNames returns the URIs of the deployed local claims.
+func (h *claimDialectHandler) GetDeployedReferenceNames() ([]string, error) {
+
+	return getLocalClaimURIs()
+}
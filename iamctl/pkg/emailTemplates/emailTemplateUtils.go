@@ -0,0 +1,97 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package emailtemplates
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+const HTML_CONTENT_TYPE = "text/html"
+const HTML_FILE_EXTENSION = ".html"
+
+var templateTypes = utils.NewTemplateTypeManager("/api/server/v1/email/template-types", utils.EMAIL_TEMPLATES)
+
+type templateReference struct {
+	Id string `json:"id"`
+}
+
+type emailTemplate struct {
+	Id          string `json:"id"`
+	ContentType string `json:"contentType"`
+	Subject     string `json:"subject"`
+	Body        string `json:"body"`
+	Footer      string `json:"footer"`
+}
+
+// EmailTemplateConfig is the format of the email template files. The body of an HTML template is stored in the file
+// given in bodyFile.
+type EmailTemplateConfig struct {
+	TemplateType string `yaml:"templateType"`
+	Locale       string `yaml:"locale"`
+	ContentType  string `yaml:"contentType"`
+	Subject      string `yaml:"subject"`
+	Body         string `yaml:"body,omitempty"`
+	BodyFile     string `yaml:"bodyFile,omitempty"`
+	Footer       string `yaml:"footer"`
+}
+
+func getTemplateLocales(templateTypeId string) ([]string, error) {
+
+	body, _, err := utils.SendJsonRequest(http.MethodGet, getTemplateUrl(templateTypeId, ""), nil, utils.EMAIL_TEMPLATES)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve the email templates of the template type. %w", err)
+	}
+	var templates []templateReference
+	if err := json.Unmarshal(body, &templates); err != nil {
+		return nil, fmt.Errorf("error when unmarshalling the retrieved email templates. %w", err)
+	}
+	var locales []string
+	for _, template := range templates {
+		locales = append(locales, template.Id)
+	}
+	return locales, nil
+}
+
+func getTemplateUrl(templateTypeId string, locale string) string {
+
+	if locale == "" {
+		return templateTypes.GetTemplateTypeUrl(templateTypeId) + "/templates"
+	}
+	return templateTypes.GetTemplateTypeUrl(templateTypeId) + "/templates/" + locale
+}
+
+// createTemplate adds the template to the template type, and creates the template type along with the template if the
+// template type is not deployed.
+func createTemplate(templateTypeName string, template emailTemplate, inventory *utils.ResourceInventory) (string, error) {
+
+	body := map[string]interface{}{
+		"displayName": templateTypeName,
+		"templates":   []emailTemplate{template},
+	}
+	templateTypeId, created, err := templateTypes.ResolveTemplateType(templateTypeName, inventory, body)
+	if err != nil || created {
+		return templateTypeId, err
+	}
+	_, _, err = utils.SendJsonRequest(http.MethodPost, getTemplateUrl(templateTypeId, ""), template, utils.EMAIL_TEMPLATES)
+	return templateTypeId, err
+}
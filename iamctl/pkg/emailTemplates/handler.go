@@ -0,0 +1,204 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package emailtemplates
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+type emailTemplateHandler struct{}
+
+func init() {
+
+	utils.RegisterResourceHandler(&emailTemplateHandler{})
+}
+
+func (h *emailTemplateHandler) GetResourceType() string {
+
+	return utils.EMAIL_TEMPLATES
+}
+
+func (h *emailTemplateHandler) GetConfigKey() string {
+
+	return utils.EMAIL_TEMPLATES_CONFIG
+}
+
+func (h *emailTemplateHandler) GetDependencies() []string {
+
+	return nil
+}
+
+func (h *emailTemplateHandler) GetArrayIdentifiers() map[string]string {
+
+	return map[string]string{}
+}
+
+func (h *emailTemplateHandler) GetDeployedResources() ([]utils.Resource, error) {
+
+	return templateTypes.GetDeployedTemplates(getTemplateLocales)
+}
+
+func (h *emailTemplateHandler) GetFileName(resource utils.Resource) string {
+
+	return utils.FormatTemplateFileName(resource.Name)
+}
+
+func (h *emailTemplateHandler) ExportResource(resource utils.Resource, format string, excludeSecrets bool) (string, []byte, error) {
+
+	templateTypeId, locale := utils.SplitTemplateResourceId(resource.Id)
+	body, _, err := utils.SendJsonRequest(http.MethodGet, getTemplateUrl(templateTypeId, locale), nil, utils.EMAIL_TEMPLATES)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while exporting the email template: %s", err)
+	}
+	var template emailTemplate
+	if err := json.Unmarshal(body, &template); err != nil {
+		return "", nil, fmt.Errorf("error when unmarshalling the exported email template: %s", err)
+	}
+
+	templateConfig := EmailTemplateConfig{
+		TemplateType: strings.TrimSuffix(resource.Name, "_"+locale),
+		Locale:       template.Id,
+		ContentType:  template.ContentType,
+		Subject:      template.Subject,
+		Body:         template.Body,
+		Footer:       template.Footer,
+	}
+	content, err := yaml.Marshal(templateConfig)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while converting the exported email template: %s", err)
+	}
+	return utils.FormatTemplateFileName(resource.Name) + ".yml", content, nil
+}
+
+// ExtractAttachments moves the body of HTML templates to a separate file, so that it can be edited as HTML.
+func (h *emailTemplateHandler) ExtractAttachments(resource utils.Resource, fileName string,
+	content []byte) ([]byte, map[string][]byte, error) {
+
+	var templateConfig EmailTemplateConfig
+	if err := yaml.Unmarshal(content, &templateConfig); err != nil {
+		return nil, nil, err
+	}
+	if !strings.EqualFold(templateConfig.ContentType, HTML_CONTENT_TYPE) {
+		return content, nil, nil
+	}
+
+	bodyFileName := utils.GetFileInfo(fileName).ResourceName + HTML_FILE_EXTENSION
+	attachments := map[string][]byte{bodyFileName: []byte(templateConfig.Body)}
+	templateConfig.Body = ""
+	templateConfig.BodyFile = bodyFileName
+
+	content, err := yaml.Marshal(templateConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	return content, attachments, nil
+}
+
+func (h *emailTemplateHandler) IsAttachment(fileName string) bool {
+
+	return strings.EqualFold(filepath.Ext(fileName), HTML_FILE_EXTENSION)
+}
+
+func (h *emailTemplateHandler) ResolveLocalResource(fileData []byte, fileInfo utils.FileInfo,
+	inventory *utils.ResourceInventory) (utils.Resource, error) {
+
+	var templateConfig EmailTemplateConfig
+	if err := yaml.Unmarshal(fileData, &templateConfig); err != nil {
+		return utils.Resource{}, fmt.Errorf("invalid file content for email template: %s. %s", fileInfo.ResourceName, err)
+	}
+	if templateConfig.TemplateType == "" || templateConfig.Locale == "" {
+		return utils.Resource{}, fmt.Errorf("templateType or locale is not defined for email template: %s", fileInfo.ResourceName)
+	}
+
+	resource := utils.Resource{Name: utils.GetTemplateResourceName(templateConfig.TemplateType, templateConfig.Locale)}
+	if template, ok := inventory.GetByName(resource.Name); ok {
+		resource.Id = template.Id
+	}
+	return resource, nil
+}
+
+func (h *emailTemplateHandler) ImportResource(resource utils.Resource, filePath string, fileData string) (string, error) {
+
+	templateConfig, template, err := h.getLocalTemplate(filePath, fileData)
+	if err != nil {
+		return "", err
+	}
+	inventory, err := utils.GetInventory(h)
+	if err != nil {
+		return "", fmt.Errorf("error when retrieving the deployed templates. %s", err)
+	}
+	templateTypeId, err := createTemplate(templateConfig.TemplateType, template, inventory)
+	if err != nil {
+		return "", err
+	}
+	return utils.GetTemplateResourceId(templateTypeId, template.Id), nil
+}
+
+func (h *emailTemplateHandler) UpdateResource(resource utils.Resource, filePath string, fileData string) error {
+
+	_, template, err := h.getLocalTemplate(filePath, fileData)
+	if err != nil {
+		return err
+	}
+	templateTypeId, locale := utils.SplitTemplateResourceId(resource.Id)
+	_, _, err = utils.SendJsonRequest(http.MethodPut, getTemplateUrl(templateTypeId, locale), template, utils.EMAIL_TEMPLATES)
+	return err
+}
+
+func (h *emailTemplateHandler) DeleteResource(resource utils.Resource) error {
+
+	templateTypeId, locale := utils.SplitTemplateResourceId(resource.Id)
+	_, _, err := utils.SendJsonRequest(http.MethodDelete, getTemplateUrl(templateTypeId, locale), nil, utils.EMAIL_TEMPLATES)
+	return err
+}
+
+func (h *emailTemplateHandler) IsDeletable(resource utils.Resource) bool {
+
+	return true
+}
+
+// getLocalTemplate returns the template defined in the local file, with the body read from the body file if defined.
+func (h *emailTemplateHandler) getLocalTemplate(filePath string, fileData string) (EmailTemplateConfig, emailTemplate, error) {
+
+	var templateConfig EmailTemplateConfig
+	if err := yaml.Unmarshal([]byte(fileData), &templateConfig); err != nil {
+		return templateConfig, emailTemplate{}, fmt.Errorf("invalid file content for email template: %s", err)
+	}
+	body := templateConfig.Body
+	if templateConfig.BodyFile != "" {
+		var err error
+		if body, err = utils.ReadAttachment(h, filePath, templateConfig.BodyFile); err != nil {
+			return templateConfig, emailTemplate{}, err
+		}
+	}
+	return templateConfig, emailTemplate{
+		Id:          templateConfig.Locale,
+		ContentType: templateConfig.ContentType,
+		Subject:     templateConfig.Subject,
+		Body:        body,
+		Footer:      templateConfig.Footer,
+	}, nil
+}
@@ -0,0 +1,210 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package groups
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+const GROUPS_ENDPOINT = "Groups"
+const USERS_ENDPOINT = "Users"
+const ROLES_ENDPOINT = "Roles"
+const GROUP_SCHEMA = "urn:ietf:params:scim:schemas:core:2.0:Group"
+const PATCH_OP_SCHEMA = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+
+type scimReference struct {
+	Value   string `json:"value"`
+	Display string `json:"display"`
+}
+
+type group struct {
+	Id          string          `json:"id"`
+	DisplayName string          `json:"displayName"`
+	Members     []scimReference `json:"members"`
+	Roles       []scimReference `json:"roles"`
+}
+
+// GroupConfig is the format of the group files, where roles and members are referred to by name.
+// Roles and members are synced only if they are defined in the file.
+type GroupConfig struct {
+	DisplayName string    `yaml:"displayName"`
+	Roles       *[]string `yaml:"roles,omitempty"`
+	Members     *[]string `yaml:"members,omitempty"`
+}
+
+func getGroupList() ([]group, error) {
+
+	resources, err := utils.GetScimResources(GROUPS_ENDPOINT, "displayName", utils.GROUPS)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []group
+	for _, resource := range resources {
+		var g group
+		if err := json.Unmarshal(resource, &g); err != nil {
+			return nil, fmt.Errorf("error when unmarshalling the retrieved group. %w", err)
+		}
+		groups = append(groups, g)
+	}
+	return groups, nil
+}
+
+func getGroup(groupId string) (group, error) {
+
+	var g group
+	body, _, err := utils.SendJsonRequest(http.MethodGet, getGroupUrl(groupId), nil, utils.GROUPS)
+	if err != nil {
+		return g, err
+	}
+	if err := json.Unmarshal(body, &g); err != nil {
+		return g, fmt.Errorf("error when unmarshalling the retrieved group. %w", err)
+	}
+	return g, nil
+}
+
+func getGroupUrl(groupId string) string {
+
+	if groupId == "" {
+		return utils.GetScimBaseUrl() + GROUPS_ENDPOINT
+	}
+	return utils.GetScimBaseUrl() + GROUPS_ENDPOINT + "/" + groupId
+}
+
+func areMembersIncluded(resourceConfigs map[string]interface{}) bool {
+
+	if includeMembers, ok := resourceConfigs[utils.INCLUDE_MEMBERS_CONFIG].(bool); ok {
+		return includeMembers
+	}
+	return false
+}
+
+// validateMembers rejects the members of a group when the users are imported in the same run. Since the users are
+// imported after the groups, the members of the group would not exist yet in a fresh environment.
+func validateMembers(filePath string) error {
+
+	usersDir := filepath.Join(filepath.Dir(filepath.Dir(filePath)), utils.USERS)
+	if _, err := os.Stat(usersDir); err == nil && utils.IsResourceTypeIncluded(utils.USERS) {
+		return fmt.Errorf("members of the group cannot be imported along with the users, since the users are " +
+			"imported after the groups. Define the groups of the users in the user files instead")
+	}
+	return nil
+}
+
+func getMemberReferences(userNames []string) ([]map[string]string, error) {
+
+	var members []map[string]string
+	for _, userName := range userNames {
+		filter := fmt.Sprintf("userName eq \"%s\"", strings.ReplaceAll(userName, "\"", "\\\""))
+		userId, err := utils.GetScimResourceId(USERS_ENDPOINT, filter, utils.GROUPS)
+		if err != nil {
+			return nil, fmt.Errorf("error when retrieving the user: %s. %s", userName, err)
+		}
+		if userId == "" {
+			return nil, fmt.Errorf("user: %s not found in the target environment", userName)
+		}
+		members = append(members, map[string]string{"value": userId, "display": userName})
+	}
+	return members, nil
+}
+
+func isApplicationRole(roleName string) bool {
+
+	return strings.HasPrefix(roleName, utils.APPLICATION_ROLE_PREFIX)
+}
+
+// syncRoles assigns the given roles to the group and removes the roles of the group that are not given. Application
+// roles are managed along with the applications, hence they are neither assigned nor removed.
+func syncRoles(groupId string, roleNames []string, assignedRoles []scimReference) error {
+
+	rolesHandler := utils.GetResourceHandler(utils.ROLES)
+	if rolesHandler == nil {
+		return fmt.Errorf("roles are not supported by the tool")
+	}
+	roleInventory, err := utils.GetInventory(rolesHandler)
+	if err != nil {
+		return fmt.Errorf("error when retrieving the deployed roles. %s", err)
+	}
+
+	var assignedRoleIds []string
+	for _, assignedRole := range assignedRoles {
+		assignedRoleIds = append(assignedRoleIds, assignedRole.Value)
+	}
+
+	var roleIds []string
+	for _, roleName := range roleNames {
+		if isApplicationRole(roleName) {
+			log.Printf("Warning: Application role: %s is not assigned to the group, since application roles are "+
+				"managed along with the applications.", roleName)
+			continue
+		}
+		role, ok := roleInventory.GetByName(roleName)
+		if !ok {
+			return fmt.Errorf("role: %s not found in the target environment", roleName)
+		}
+		roleIds = append(roleIds, role.Id)
+		if !utils.Contains(assignedRoleIds, role.Id) {
+			log.Printf("Assigning role: %s to the group.", roleName)
+			if err := patchRoleGroups(role.Id, "add", groupId); err != nil {
+				return fmt.Errorf("error when assigning role: %s. %s", roleName, err)
+			}
+		}
+	}
+	for _, assignedRole := range assignedRoles {
+		if !isApplicationRole(assignedRole.Display) && !utils.Contains(roleIds, assignedRole.Value) {
+			log.Printf("Removing role: %s from the group.", assignedRole.Display)
+			if err := patchRoleGroups(assignedRole.Value, "remove", groupId); err != nil {
+				return fmt.Errorf("error when removing role: %s. %s", assignedRole.Display, err)
+			}
+		}
+	}
+	return nil
+}
+
+func patchRoleGroups(roleId string, operation string, groupId string) error {
+
+	patchOperation := map[string]interface{}{"op": operation}
+	if operation == "add" {
+		patchOperation["value"] = map[string]interface{}{
+			"groups": []map[string]string{{"value": groupId}},
+		}
+	} else {
+		patchOperation["path"] = fmt.Sprintf("groups[value eq %s]", groupId)
+	}
+	body := map[string]interface{}{
+		"schemas":    []string{PATCH_OP_SCHEMA},
+		"Operations": []interface{}{patchOperation},
+	}
+	_, _, err := utils.SendJsonRequest(http.MethodPatch, utils.GetScimBaseUrl()+ROLES_ENDPOINT+"/"+roleId, body, utils.GROUPS)
+	return err
+}
+
+func formatFileName(groupName string) string {
+
+	// Group names of secondary user stores contain the user store domain separated by a slash.
+	return strings.ReplaceAll(groupName, "/", "_")
+}
@@ -0,0 +1,213 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package groups
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+type groupHandler struct{}
+
+func init() {
+
+	utils.RegisterResourceHandler(&groupHandler{})
+}
+
+func (h *groupHandler) GetResourceType() string {
+
+	return utils.GROUPS
+}
+
+func (h *groupHandler) GetConfigKey() string {
+
+	return utils.GROUPS_CONFIG
+}
+
+func (h *groupHandler) GetDependencies() []string {
+
+	return []string{utils.USERSTORES, utils.ROLES}
+}
+
+func (h *groupHandler) GetArrayIdentifiers() map[string]string {
+
+	return map[string]string{}
+}
+
+func (h *groupHandler) GetDeployedResources() ([]utils.Resource, error) {
+
+	groups, err := getGroupList()
+	if err != nil {
+		return nil, err
+	}
+	var resources []utils.Resource
+	for _, group := range groups {
+		resources = append(resources, utils.Resource{Id: group.Id, Name: group.DisplayName})
+	}
+	return resources, nil
+}
+
+func (h *groupHandler) GetFileName(resource utils.Resource) string {
+
+	return formatFileName(resource.Name)
+}
+
+func (h *groupHandler) ExportResource(resource utils.Resource, format string, excludeSecrets bool) (string, []byte, error) {
+
+	group, err := getGroup(resource.Id)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while exporting the group: %s", err)
+	}
+
+	roles := []string{}
+	for _, role := range group.Roles {
+		if isApplicationRole(role.Display) {
+			log.Printf("Warning: Application role: %s of the group: %s is not exported, since application roles are "+
+				"managed along with the applications.", role.Display, group.DisplayName)
+			continue
+		}
+		roles = append(roles, role.Display)
+	}
+	groupConfig := GroupConfig{
+		DisplayName: group.DisplayName,
+		Roles:       &roles,
+	}
+	if areMembersIncluded(utils.GetResourceToolConfigs(h)) {
+		members := []string{}
+		for _, member := range group.Members {
+			members = append(members, member.Display)
+		}
+		groupConfig.Members = &members
+	}
+
+	content, err := yaml.Marshal(groupConfig)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while converting the exported group: %s", err)
+	}
+	return formatFileName(resource.Name) + ".yml", content, nil
+}
+
+func (h *groupHandler) ResolveLocalResource(fileData []byte, fileInfo utils.FileInfo,
+	inventory *utils.ResourceInventory) (utils.Resource, error) {
+
+	var groupConfig GroupConfig
+	err := yaml.Unmarshal(fileData, &groupConfig)
+	if err != nil {
+		return utils.Resource{}, fmt.Errorf("invalid file content for group: %s. %s", fileInfo.ResourceName, err)
+	}
+	if groupConfig.DisplayName == "" {
+		return utils.Resource{}, fmt.Errorf("displayName is not defined for group: %s", fileInfo.ResourceName)
+	}
+
+	resource := utils.Resource{Name: groupConfig.DisplayName}
+	if group, ok := inventory.GetByName(groupConfig.DisplayName); ok {
+		resource.Id = group.Id
+	}
+	return resource, nil
+}
+
+func (h *groupHandler) ImportResource(resource utils.Resource, filePath string, fileData string) (string, error) {
+
+	var groupConfig GroupConfig
+	if err := yaml.Unmarshal([]byte(fileData), &groupConfig); err != nil {
+		return "", fmt.Errorf("invalid file content for group: %s", err)
+	}
+
+	body := map[string]interface{}{
+		"schemas":     []string{GROUP_SCHEMA},
+		"displayName": groupConfig.DisplayName,
+	}
+	if groupConfig.Members != nil {
+		if err := validateMembers(filePath); err != nil {
+			return "", err
+		}
+		members, err := getMemberReferences(*groupConfig.Members)
+		if err != nil {
+			return "", err
+		}
+		body["members"] = members
+	}
+
+	respBody, _, err := utils.SendJsonRequest(http.MethodPost, getGroupUrl(""), body, utils.GROUPS)
+	if err != nil {
+		return "", err
+	}
+	var createdGroup group
+	if err := json.Unmarshal(respBody, &createdGroup); err != nil || createdGroup.Id == "" {
+		return "", fmt.Errorf("group created, but the roles are not assigned since the group ID is not returned")
+	}
+	if groupConfig.Roles != nil {
+		if err := syncRoles(createdGroup.Id, *groupConfig.Roles, nil); err != nil {
+			return createdGroup.Id, err
+		}
+	}
+	return createdGroup.Id, nil
+}
+
+func (h *groupHandler) UpdateResource(resource utils.Resource, filePath string, fileData string) error {
+
+	var groupConfig GroupConfig
+	if err := yaml.Unmarshal([]byte(fileData), &groupConfig); err != nil {
+		return fmt.Errorf("invalid file content for group: %s", err)
+	}
+
+	if groupConfig.Members != nil {
+		if err := validateMembers(filePath); err != nil {
+			return err
+		}
+		members, err := getMemberReferences(*groupConfig.Members)
+		if err != nil {
+			return err
+		}
+		body := map[string]interface{}{
+			"schemas": []string{PATCH_OP_SCHEMA},
+			"Operations": []interface{}{
+				map[string]interface{}{"op": "replace", "path": "members", "value": members},
+			},
+		}
+		if _, _, err := utils.SendJsonRequest(http.MethodPatch, getGroupUrl(resource.Id), body, utils.GROUPS); err != nil {
+			return fmt.Errorf("error when updating the group members. %s", err)
+		}
+	}
+
+	if groupConfig.Roles == nil {
+		return nil
+	}
+	group, err := getGroup(resource.Id)
+	if err != nil {
+		return err
+	}
+	return syncRoles(resource.Id, *groupConfig.Roles, group.Roles)
+}
+
+func (h *groupHandler) DeleteResource(resource utils.Resource) error {
+
+	_, _, err := utils.SendJsonRequest(http.MethodDelete, getGroupUrl(resource.Id), nil, utils.GROUPS)
+	return err
+}
+
+func (h *groupHandler) IsDeletable(resource utils.Resource) bool {
+
+	return true
+}
@@ -0,0 +1,77 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package identityproviders
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+const AUTHENTICATOR_ATTRIBUTE = "authenticator"
+
+// GetDeployedAttributes returns the names of the federated authenticators of the deployed identity provider.
+func (h *idpHandler) GetDeployedAttributes(resource utils.Resource) (map[string][]string, error) {
+
+	attributes := map[string][]string{AUTHENTICATOR_ATTRIBUTE: {}}
+	if resource.Id == utils.RESIDENT_IDP_NAME {
+		// The resident identity provider does not have federated authenticators.
+		return attributes, nil
+	}
+
+	reqUrl := utils.GetServerBaseUrl() + "/api/server/v1/identity-providers/" + resource.Id + "/federated-authenticators"
+	body, _, err := utils.SendJsonRequest(http.MethodGet, reqUrl, nil, utils.IDENTITY_PROVIDERS)
+	if err != nil {
+		return nil, fmt.Errorf("error when retrieving the authenticators of the identity provider: %s. %s", resource.Name, err)
+	}
+	var authenticatorList struct {
+		Authenticators []struct {
+			Name string `json:"name"`
+		} `json:"authenticators"`
+	}
+	if err := json.Unmarshal(body, &authenticatorList); err != nil {
+		return nil, fmt.Errorf("error when unmarshalling the authenticators of the identity provider: %s. %s", resource.Name, err)
+	}
+	for _, authenticator := range authenticatorList.Authenticators {
+		attributes[AUTHENTICATOR_ATTRIBUTE] = append(attributes[AUTHENTICATOR_ATTRIBUTE], authenticator.Name)
+	}
+	return attributes, nil
+}
+
+// GetLocalAttributes returns the names of the federated authenticators of the identity provider in the local file.
+func (h *idpHandler) GetLocalAttributes(fileData []byte) (map[string][]string, error) {
+
+	var idpConfig struct {
+		FederatedAuthenticatorConfigs []struct {
+			Name string `yaml:"name"`
+		} `yaml:"federatedAuthenticatorConfigs"`
+	}
+	if err := yaml.Unmarshal(fileData, &idpConfig); err != nil {
+		return nil, fmt.Errorf("invalid file content for identity provider. %s", err)
+	}
+
+	attributes := map[string][]string{AUTHENTICATOR_ATTRIBUTE: {}}
+	for _, authenticator := range idpConfig.FederatedAuthenticatorConfigs {
+		attributes[AUTHENTICATOR_ATTRIBUTE] = append(attributes[AUTHENTICATOR_ATTRIBUTE], authenticator.Name)
+	}
+	return attributes, nil
+}
@@ -0,0 +1,134 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package identityproviders
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+type idpHandler struct{}
+
+func init() {
+
+	utils.RegisterResourceHandler(&idpHandler{})
+}
+
+func (h *idpHandler) GetResourceType() string {
+
+	return utils.IDENTITY_PROVIDERS
+}
+
+func (h *idpHandler) GetConfigKey() string {
+
+	return utils.IDP_CONFIG
+}
+
+func (h *idpHandler) GetDependencies() []string {
+
+	return []string{utils.CLAIMS}
+}
+
+func (h *idpHandler) GetArrayIdentifiers() map[string]string {
+
+	return utils.GetArrayIdentifiers(utils.IDENTITY_PROVIDERS)
+}
+
+func (h *idpHandler) GetDeployedResources() ([]utils.Resource, error) {
+
+	idps, err := getIdpList()
+	if err != nil {
+		return nil, err
+	}
+	var resources []utils.Resource
+	for _, idp := range idps {
+		resources = append(resources, utils.Resource{Id: idp.Id, Name: idp.Name})
+	}
+	// The resident identity provider is not listed with the other identity providers.
+	resources = append(resources, utils.Resource{Id: utils.RESIDENT_IDP_NAME, Name: utils.RESIDENT_IDP_NAME})
+	return resources, nil
+}
+
+func (h *idpHandler) GetFileName(resource utils.Resource) string {
+
+	return resource.Name
+}
+
+func (h *idpHandler) ExportResource(resource utils.Resource, format string, excludeSecrets bool) (string, []byte, error) {
+
+	resp, err := utils.SendExportRequest(resource.Id, utils.GetFileType(format), utils.IDENTITY_PROVIDERS, excludeSecrets)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while exporting the identity provider: %s", err)
+	}
+	defer resp.Body.Close()
+
+	fileName, err := utils.GetExportedFileName(resp)
+	if err != nil {
+		return "", nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while reading the response body when exporting IDP: %s. %s", fileName, err)
+	}
+	return fileName, body, nil
+}
+
+func (h *idpHandler) ResolveLocalResource(fileData []byte, fileInfo utils.FileInfo,
+	inventory *utils.ResourceInventory) (utils.Resource, error) {
+
+	if fileInfo.ResourceName == utils.RESIDENT_IDP_NAME {
+		return utils.Resource{Id: utils.RESIDENT_IDP_NAME, Name: utils.RESIDENT_IDP_NAME}, nil
+	}
+
+	var idpConfig idpConfig
+	err := yaml.Unmarshal(fileData, &idpConfig)
+	if err != nil {
+		return utils.Resource{}, fmt.Errorf("invalid file content for idp: %s. %s", fileInfo.ResourceName, err)
+	}
+
+	resource := utils.Resource{Name: idpConfig.IdentityProviderName}
+	if idp, ok := inventory.GetByName(idpConfig.IdentityProviderName); ok {
+		resource.Id = idp.Id
+	}
+	return resource, nil
+}
+
+func (h *idpHandler) ImportResource(resource utils.Resource, filePath string, fileData string) (string, error) {
+
+	return utils.SendImportRequest(filePath, fileData, utils.IDENTITY_PROVIDERS)
+}
+
+func (h *idpHandler) UpdateResource(resource utils.Resource, filePath string, fileData string) error {
+
+	return utils.SendUpdateRequest(resource.Id, filePath, fileData, utils.IDENTITY_PROVIDERS)
+}
+
+func (h *idpHandler) DeleteResource(resource utils.Resource) error {
+
+	return utils.SendDeleteRequest(resource.Id, utils.IDENTITY_PROVIDERS)
+}
+
+func (h *idpHandler) IsDeletable(resource utils.Resource) bool {
+
+	return resource.Name != utils.RESIDENT_IDP_NAME
+}
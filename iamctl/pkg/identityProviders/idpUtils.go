@@ -103,25 +103,3 @@ func getTotalIdpCount() (count int, err error) {
 	}
 	return -1, fmt.Errorf("error while retrieving identity provider count")
 }
-
-func getDeployedIdpNames() []string {
-
-	idps, err := getIdpList()
-	if err != nil {
-		return []string{}
-	}
-
-	var idpNames []string
-	for _, idp := range idps {
-		idpNames = append(idpNames, idp.Name)
-	}
-	return idpNames
-}
-
-func getIdpKeywordMapping(idpName string) map[string]interface{} {
-
-	if utils.KEYWORD_CONFIGS.IdpConfigs != nil {
-		return utils.ResolveAdvancedKeywordMapping(idpName, utils.KEYWORD_CONFIGS.IdpConfigs)
-	}
-	return utils.KEYWORD_CONFIGS.KeywordMappings
-}
@@ -0,0 +1,52 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package identityproviders
+
+import (
+	"fmt"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// idpReferenceConfig contains the fields of an identity provider that refer to resources of other types.
+type idpReferenceConfig struct {
+	ClaimConfig struct {
+		ClaimMappings []struct {
+			LocalClaim struct {
+				ClaimUri string `yaml:"claimUri"`
+			} `yaml:"localClaim"`
+		} `yaml:"claimMappings"`
+	} `yaml:"claimConfig"`
+}
+
+// GetReferences returns the local claims that the claims of the identity provider are mapped to.
+func (h *idpHandler) GetReferences(fileData []byte) (map[string][]string, error) {
+
+	var idpConfig idpReferenceConfig
+	if err := yaml.Unmarshal(fileData, &idpConfig); err != nil {
+		return nil, fmt.Errorf("invalid file content for identity provider. %s", err)
+	}
+
+	references := make(map[string][]string)
+	for _, claimMapping := range idpConfig.ClaimConfig.ClaimMappings {
+		references[utils.CLAIMS] = append(references[utils.CLAIMS], claimMapping.LocalClaim.ClaimUri)
+	}
+	return references, nil
+}
@@ -0,0 +1,162 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package oidcscopes
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+type oidcScopeHandler struct{}
+
+func init() {
+
+	utils.RegisterResourceHandler(&oidcScopeHandler{})
+}
+
+func (h *oidcScopeHandler) GetResourceType() string {
+
+	return utils.OIDC_SCOPES
+}
+
+func (h *oidcScopeHandler) GetConfigKey() string {
+
+	return utils.OIDC_SCOPES_CONFIG
+}
+
+func (h *oidcScopeHandler) GetDependencies() []string {
+
+	return []string{utils.CLAIMS}
+}
+
+func (h *oidcScopeHandler) GetArrayIdentifiers() map[string]string {
+
+	return map[string]string{}
+}
+
+func (h *oidcScopeHandler) GetDeployedResources() ([]utils.Resource, error) {
+
+	scopes, err := getScopeList()
+	if err != nil {
+		return nil, err
+	}
+	var resources []utils.Resource
+	for _, scope := range scopes {
+		// OIDC scopes are identified by the scope name.
+		resources = append(resources, utils.Resource{Id: scope.Name, Name: scope.Name})
+	}
+	return resources, nil
+}
+
+func (h *oidcScopeHandler) GetFileName(resource utils.Resource) string {
+
+	return resource.Name
+}
+
+func (h *oidcScopeHandler) ExportResource(resource utils.Resource, format string, excludeSecrets bool) (string, []byte, error) {
+
+	body, _, err := utils.SendJsonRequest(http.MethodGet, getScopeUrl(resource.Id), nil, utils.OIDC_SCOPES)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while exporting the OIDC scope: %s", err)
+	}
+
+	content, err := utils.JsonToYaml(body)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while converting the exported OIDC scope: %s", err)
+	}
+	return resource.Name + ".yml", content, nil
+}
+
+func (h *oidcScopeHandler) ResolveLocalResource(fileData []byte, fileInfo utils.FileInfo,
+	inventory *utils.ResourceInventory) (utils.Resource, error) {
+
+	var scope oidcScope
+	if err := yaml.Unmarshal(fileData, &scope); err != nil {
+		return utils.Resource{}, fmt.Errorf("invalid file content for OIDC scope: %s. %s", fileInfo.ResourceName, err)
+	}
+	if scope.Name == "" {
+		return utils.Resource{}, fmt.Errorf("name is not defined for OIDC scope: %s", fileInfo.ResourceName)
+	}
+
+	resource := utils.Resource{Name: scope.Name}
+	if deployedScope, ok := inventory.GetByName(scope.Name); ok {
+		resource.Id = deployedScope.Id
+	}
+	return resource, nil
+}
+
+func (h *oidcScopeHandler) ImportResource(resource utils.Resource, filePath string, fileData string) (string, error) {
+
+	scope, err := getLocalScope(filePath, fileData)
+	if err != nil {
+		return "", err
+	}
+	_, _, err = utils.SendJsonRequest(http.MethodPost, getScopeUrl(""), scope, utils.OIDC_SCOPES)
+	if err != nil {
+		return "", err
+	}
+	return scope.Name, nil
+}
+
+func (h *oidcScopeHandler) UpdateResource(resource utils.Resource, filePath string, fileData string) error {
+
+	scope, err := getLocalScope(filePath, fileData)
+	if err != nil {
+		return err
+	}
+
+	// The scope name cannot be updated, hence it is not accepted in the update request.
+	body := map[string]interface{}{
+		"displayName": scope.DisplayName,
+		"description": scope.Description,
+		"claims":      scope.Claims,
+	}
+	_, _, err = utils.SendJsonRequest(http.MethodPut, getScopeUrl(resource.Id), body, utils.OIDC_SCOPES)
+	return err
+}
+
+func (h *oidcScopeHandler) DeleteResource(resource utils.Resource) error {
+
+	_, _, err := utils.SendJsonRequest(http.MethodDelete, getScopeUrl(resource.Id), nil, utils.OIDC_SCOPES)
+	return err
+}
+
+func (h *oidcScopeHandler) IsDeletable(resource utils.Resource) bool {
+
+	return !utils.Contains(systemScopes, resource.Name)
+}
+
+// getLocalScope returns the scope defined in the local file after validating the claims of the scope.
+func getLocalScope(filePath string, fileData string) (oidcScope, error) {
+
+	var scope oidcScope
+	if err := yaml.Unmarshal([]byte(fileData), &scope); err != nil {
+		return scope, fmt.Errorf("invalid file content for OIDC scope: %s", err)
+	}
+	if scope.Claims == nil {
+		scope.Claims = []string{}
+	}
+	if err := validateClaims(scope.Claims, filePath); err != nil {
+		return scope, err
+	}
+	return scope, nil
+}
@@ -0,0 +1,193 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package oidcscopes
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"sync"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+const OIDC_CLAIM_DIALECT = "http://wso2.org/oidc/claim"
+
+// The openid scope is required for OIDC authentication and cannot be removed.
+var systemScopes = []string{"openid"}
+
+type oidcScope struct {
+	Name        string   `json:"name" yaml:"name"`
+	DisplayName string   `json:"displayName" yaml:"displayName"`
+	Description string   `json:"description" yaml:"description"`
+	Claims      []string `json:"claims" yaml:"claims"`
+}
+
+type externalClaim struct {
+	Id       string `json:"id"`
+	ClaimURI string `json:"claimURI"`
+}
+
+type localClaimDialect struct {
+	Claims []struct {
+		ClaimURI string `yaml:"claimURI"`
+	} `yaml:"claims"`
+}
+
+// oidcClaimCache holds the OIDC claims retrieved for the claim dialects of the current target environment, so that
+// they are retrieved once per run instead of for each scope. The claims defined in the local OIDC claim dialect files
+// are cached by the local claims directory.
+var oidcClaimCache = struct {
+	mutex           sync.Mutex
+	claimDialects   *utils.ResourceInventory
+	deployedClaims  []string
+	localClaimsDirs map[string][]string
+}{localClaimsDirs: make(map[string][]string)}
+
+func getScopeList() ([]oidcScope, error) {
+
+	body, _, err := utils.SendJsonRequest(http.MethodGet, getScopeUrl(""), nil, utils.OIDC_SCOPES)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve available OIDC scope list. %w", err)
+	}
+	var scopes []oidcScope
+	if err := json.Unmarshal(body, &scopes); err != nil {
+		return nil, fmt.Errorf("error when unmarshalling the retrieved OIDC scope list. %w", err)
+	}
+	return scopes, nil
+}
+
+func getScopeUrl(scopeName string) string {
+
+	if scopeName == "" {
+		return utils.GetServerBaseUrl() + "/api/server/v1/oidc/scopes"
+	}
+	return utils.GetServerBaseUrl() + "/api/server/v1/oidc/scopes/" + url.PathEscape(scopeName)
+}
+
+// validateClaims checks whether the claims of the scope exist in the OIDC claim dialect of the target environment, or
+// in the local OIDC claim dialect file, which is imported before the scopes.
+func validateClaims(claims []string, filePath string) error {
+
+	if len(claims) == 0 {
+		return nil
+	}
+	deployedClaims, err := getDeployedOidcClaims()
+	if err != nil {
+		return err
+	}
+	localClaims := getLocalOidcClaims(filepath.Join(filepath.Dir(filepath.Dir(filePath)), utils.CLAIMS))
+
+	var missingClaims []string
+	for _, claim := range claims {
+		if !utils.Contains(deployedClaims, claim) && !utils.Contains(localClaims, claim) {
+			missingClaims = append(missingClaims, claim)
+		}
+	}
+	if len(missingClaims) > 0 {
+		return fmt.Errorf("claims: %v not found in the claim dialect: %s locally or in the target environment",
+			missingClaims, OIDC_CLAIM_DIALECT)
+	}
+	return nil
+}
+
+// getDeployedOidcClaims returns the URIs of the claims in the OIDC claim dialect of the target environment, or nil if the
+// claim dialect is not deployed. The claims are retrieved again only if the deployed claim dialects are retrieved again, as when the target tenant changes.
+func getDeployedOidcClaims() ([]string, error) {
+
+	claimHandler := utils.GetResourceHandler(utils.CLAIMS)
+	if claimHandler == nil {
+		return nil, fmt.Errorf("claims are not supported by the tool")
+	}
+	claimInventory, err := utils.GetInventory(claimHandler)
+	if err != nil {
+		return nil, fmt.Errorf("error when retrieving the deployed claim dialects. %s", err)
+	}
+
+	oidcClaimCache.mutex.Lock()
+	defer oidcClaimCache.mutex.Unlock()
+	if oidcClaimCache.claimDialects == claimInventory {
+		return oidcClaimCache.deployedClaims, nil
+	}
+
+	var claimURIs []string
+	dialect, ok := claimInventory.GetByName(OIDC_CLAIM_DIALECT)
+	if !ok {
+		// The claim dialect can still be defined in the local files.
+		oidcClaimCache.claimDialects = claimInventory
+		oidcClaimCache.deployedClaims = nil
+		return nil, nil
+	}
+	claimsUrl := utils.GetServerBaseUrl() + "/api/server/v1/claim-dialects/" + dialect.Id + "/claims"
+	body, _, err := utils.SendJsonRequest(http.MethodGet, claimsUrl, nil, utils.OIDC_SCOPES)
+	if err != nil {
+		return nil, fmt.Errorf("error when retrieving the OIDC claims. %s", err)
+	}
+	var oidcClaims []externalClaim
+	if err := json.Unmarshal(body, &oidcClaims); err != nil {
+		return nil, fmt.Errorf("error when unmarshalling the retrieved OIDC claims. %s", err)
+	}
+
+	for _, oidcClaim := range oidcClaims {
+		claimURIs = append(claimURIs, oidcClaim.ClaimURI)
+	}
+	oidcClaimCache.claimDialects = claimInventory
+	oidcClaimCache.deployedClaims = claimURIs
+	return claimURIs, nil
+}
+
+// getLocalOidcClaims returns the URIs of the claims defined in the OIDC claim dialect file of the local claims
+// directory. Returns nil if the directory or the file does not exist.
+func getLocalOidcClaims(claimsDirPath string) []string {
+
+	oidcClaimCache.mutex.Lock()
+	defer oidcClaimCache.mutex.Unlock()
+	if claimURIs, ok := oidcClaimCache.localClaimsDirs[claimsDirPath]; ok {
+		return claimURIs
+	}
+
+	var claimURIs []string
+	claimHandler := utils.GetResourceHandler(utils.CLAIMS)
+	files, err := ioutil.ReadDir(claimsDirPath)
+	if claimHandler != nil && err == nil {
+		for _, file := range files {
+			if file.IsDir() {
+				continue
+			}
+			localResources, err := utils.ResolveLocalFile(claimHandler, filepath.Join(claimsDirPath, file.Name()),
+				utils.NewResourceInventory(nil))
+			if err != nil || len(localResources) != 1 || localResources[0].Resource.Name != OIDC_CLAIM_DIALECT {
+				continue
+			}
+			var dialect localClaimDialect
+			if err := yaml.Unmarshal([]byte(localResources[0].FileData), &dialect); err != nil {
+				continue
+			}
+			for _, claim := range dialect.Claims {
+				claimURIs = append(claimURIs, claim.ClaimURI)
+			}
+		}
+	}
+	oidcClaimCache.localClaimsDirs[claimsDirPath] = claimURIs
+	return claimURIs
+}
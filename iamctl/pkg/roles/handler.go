@@ -0,0 +1,150 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package roles
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+type roleHandler struct{}
+
+func init() {
+
+	utils.RegisterResourceHandler(&roleHandler{})
+}
+
+func (h *roleHandler) GetResourceType() string {
+
+	return utils.ROLES
+}
+
+func (h *roleHandler) GetConfigKey() string {
+
+	return utils.ROLES_CONFIG
+}
+
+func (h *roleHandler) GetDependencies() []string {
+
+	return nil
+}
+
+func (h *roleHandler) GetArrayIdentifiers() map[string]string {
+
+	return map[string]string{
+		"permissions": "value",
+	}
+}
+
+func (h *roleHandler) GetDeployedResources() ([]utils.Resource, error) {
+
+	roles, err := getRoleList()
+	if err != nil {
+		return nil, err
+	}
+	var resources []utils.Resource
+	for _, role := range roles {
+		resources = append(resources, utils.Resource{Id: role.Id, Name: role.DisplayName})
+	}
+	return resources, nil
+}
+
+func (h *roleHandler) GetFileName(resource utils.Resource) string {
+
+	return formatFileName(resource.Name)
+}
+
+func (h *roleHandler) ExportResource(resource utils.Resource, format string, excludeSecrets bool) (string, []byte, error) {
+
+	body, _, err := utils.SendJsonRequest(http.MethodGet, getRoleUrl(resource.Id), nil, utils.ROLES)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while exporting the role: %s", err)
+	}
+
+	content, err := utils.JsonToYaml(body, environmentSpecificFields...)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while converting the exported role: %s", err)
+	}
+	return formatFileName(resource.Name) + ".yml", content, nil
+}
+
+func (h *roleHandler) ResolveLocalResource(fileData []byte, fileInfo utils.FileInfo,
+	inventory *utils.ResourceInventory) (utils.Resource, error) {
+
+	var roleConfig RoleConfig
+	err := yaml.Unmarshal(fileData, &roleConfig)
+	if err != nil {
+		return utils.Resource{}, fmt.Errorf("invalid file content for role: %s. %s", fileInfo.ResourceName, err)
+	}
+	if roleConfig.DisplayName == "" {
+		return utils.Resource{}, fmt.Errorf("displayName is not defined for role: %s", fileInfo.ResourceName)
+	}
+
+	resource := utils.Resource{Name: roleConfig.DisplayName}
+	if role, ok := inventory.GetByName(roleConfig.DisplayName); ok {
+		resource.Id = role.Id
+	}
+	return resource, nil
+}
+
+func (h *roleHandler) ImportResource(resource utils.Resource, filePath string, fileData string) (string, error) {
+
+	roleConfig, err := utils.YamlToMap([]byte(fileData))
+	if err != nil {
+		return "", err
+	}
+	roleConfig["schemas"] = []string{ROLE_SCHEMA}
+
+	body, _, err := utils.SendJsonRequest(http.MethodPost, getRoleUrl(""), roleConfig, utils.ROLES)
+	if err != nil {
+		return "", err
+	}
+	var createdRole role
+	if err := json.Unmarshal(body, &createdRole); err != nil {
+		return "", fmt.Errorf("error when unmarshalling the created role. %w", err)
+	}
+	return createdRole.Id, nil
+}
+
+func (h *roleHandler) UpdateResource(resource utils.Resource, filePath string, fileData string) error {
+
+	roleConfig, err := utils.YamlToMap([]byte(fileData))
+	if err != nil {
+		return err
+	}
+
+	// Patch the role to keep the users and groups assigned to the role in the target environment.
+	_, _, err = utils.SendJsonRequest(http.MethodPatch, getRoleUrl(resource.Id), getPatchRequestBody(roleConfig), utils.ROLES)
+	return err
+}
+
+func (h *roleHandler) DeleteResource(resource utils.Resource) error {
+
+	_, _, err := utils.SendJsonRequest(http.MethodDelete, getRoleUrl(resource.Id), nil, utils.ROLES)
+	return err
+}
+
+func (h *roleHandler) IsDeletable(resource utils.Resource) bool {
+
+	return !utils.Contains(systemRoles, resource.Name)
+}
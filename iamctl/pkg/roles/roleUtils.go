@@ -0,0 +1,100 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package roles
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+const ROLES_ENDPOINT = "Roles"
+const ROLE_SCHEMA = "urn:ietf:params:scim:schemas:extension:2.0:Role"
+
+// Roles that are created by the server and should not be deleted by the tool.
+var systemRoles = []string{"admin", "everyone", "system", "Internal/admin", "Internal/everyone", "Internal/system"}
+
+// Fields of a role that are specific to an environment, which are not exported.
+var environmentSpecificFields = []string{"id", "meta", "schemas", "users", "groups"}
+
+type role struct {
+	Id          string `json:"id"`
+	DisplayName string `json:"displayName"`
+}
+
+type RoleConfig struct {
+	DisplayName string `yaml:"displayName"`
+}
+
+func getRoleList() ([]role, error) {
+
+	resources, err := utils.GetScimResources(ROLES_ENDPOINT, "displayName", utils.ROLES)
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []role
+	for _, resource := range resources {
+		var r role
+		if err := json.Unmarshal(resource, &r); err != nil {
+			return nil, fmt.Errorf("error when unmarshalling the retrieved role. %w", err)
+		}
+		// Application roles are managed along with the applications.
+		if strings.HasPrefix(r.DisplayName, utils.APPLICATION_ROLE_PREFIX) {
+			continue
+		}
+		roles = append(roles, r)
+	}
+	return roles, nil
+}
+
+func getRoleUrl(roleId string) string {
+
+	if roleId == "" {
+		return utils.GetScimBaseUrl() + ROLES_ENDPOINT
+	}
+	return utils.GetScimBaseUrl() + ROLES_ENDPOINT + "/" + roleId
+}
+
+func getPatchRequestBody(roleConfig map[string]interface{}) map[string]interface{} {
+
+	var operations []map[string]interface{}
+	for key, value := range roleConfig {
+		if key == "displayName" {
+			continue
+		}
+		operations = append(operations, map[string]interface{}{
+			"op":    "replace",
+			"path":  key,
+			"value": value,
+		})
+	}
+	return map[string]interface{}{
+		"schemas":    []string{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+		"Operations": operations,
+	}
+}
+
+func formatFileName(roleName string) string {
+
+	// Role names of secondary user stores contain the user store domain separated by a slash.
+	return strings.ReplaceAll(roleName, "/", "_")
+}
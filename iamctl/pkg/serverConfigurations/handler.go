@@ -0,0 +1,168 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package serverconfigurations
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+type serverConfigurationHandler struct{}
+
+func init() {
+
+	utils.RegisterResourceHandler(&serverConfigurationHandler{})
+}
+
+func (h *serverConfigurationHandler) GetResourceType() string {
+
+	return utils.SERVER_CONFIGURATIONS
+}
+
+func (h *serverConfigurationHandler) GetConfigKey() string {
+
+	return utils.SERVER_CONFIGURATIONS_CONFIG
+}
+
+func (h *serverConfigurationHandler) GetDependencies() []string {
+
+	return nil
+}
+
+func (h *serverConfigurationHandler) GetArrayIdentifiers() map[string]string {
+
+	return map[string]string{
+		"connectors": "name",
+		"properties": "name",
+	}
+}
+
+func (h *serverConfigurationHandler) GetDeployedResources() ([]utils.Resource, error) {
+
+	categories, err := getCategoryList()
+	if err != nil {
+		return nil, err
+	}
+	var resources []utils.Resource
+	for _, category := range categories {
+		resources = append(resources, utils.Resource{Id: category.Id, Name: category.Name})
+	}
+	return resources, nil
+}
+
+func (h *serverConfigurationHandler) GetFileName(resource utils.Resource) string {
+
+	return formatFileName(resource.Name)
+}
+
+func (h *serverConfigurationHandler) ExportResource(resource utils.Resource, format string, excludeSecrets bool) (string, []byte, error) {
+
+	connectors, err := getConnectors(resource.Id)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while exporting the server configurations: %s", err)
+	}
+
+	// Read-only properties are not exported since they cannot be updated.
+	for i, connector := range connectors {
+		var properties []connectorProperty
+		for _, property := range connector.Properties {
+			if !property.ReadOnly {
+				properties = append(properties, property)
+			}
+		}
+		connectors[i].Properties = properties
+	}
+
+	content, err := yaml.Marshal(ServerConfigurationConfig{Name: resource.Name, Connectors: connectors})
+	if err != nil {
+		return "", nil, fmt.Errorf("error while converting the exported server configurations: %s", err)
+	}
+	return formatFileName(resource.Name) + ".yml", content, nil
+}
+
+func (h *serverConfigurationHandler) ResolveLocalResource(fileData []byte, fileInfo utils.FileInfo,
+	inventory *utils.ResourceInventory) (utils.Resource, error) {
+
+	var config ServerConfigurationConfig
+	if err := yaml.Unmarshal(fileData, &config); err != nil {
+		return utils.Resource{}, fmt.Errorf("invalid file content for server configurations: %s. %s", fileInfo.ResourceName, err)
+	}
+	if config.Name == "" {
+		return utils.Resource{}, fmt.Errorf("name is not defined for server configurations: %s", fileInfo.ResourceName)
+	}
+
+	resource := utils.Resource{Name: config.Name}
+	if category, ok := inventory.GetByName(config.Name); ok {
+		resource.Id = category.Id
+	}
+	return resource, nil
+}
+
+func (h *serverConfigurationHandler) ImportResource(resource utils.Resource, filePath string, fileData string) (string, error) {
+
+	return "", fmt.Errorf("governance connector category: %s not found in the target environment. "+
+		"Governance connectors cannot be created through the tool", resource.Name)
+}
+
+func (h *serverConfigurationHandler) UpdateResource(resource utils.Resource, filePath string, fileData string) error {
+
+	var config ServerConfigurationConfig
+	if err := yaml.Unmarshal([]byte(fileData), &config); err != nil {
+		return fmt.Errorf("invalid file content for server configurations: %s", err)
+	}
+	deployedConnectors, err := getConnectors(resource.Id)
+	if err != nil {
+		return err
+	}
+
+	for _, localConnector := range config.Connectors {
+		connectorId := ""
+		for _, deployedConnector := range deployedConnectors {
+			if deployedConnector.Name == localConnector.Name {
+				connectorId = deployedConnector.Id
+				break
+			}
+		}
+		if connectorId == "" {
+			return fmt.Errorf("governance connector: %s not found in the target environment", localConnector.Name)
+		}
+		if len(localConnector.Properties) == 0 {
+			continue
+		}
+		log.Printf("Updating the governance connector: %s", localConnector.Name)
+		if err := patchConnector(resource.Id, connectorId, localConnector.Properties); err != nil {
+			return fmt.Errorf("error when updating the governance connector: %s. %s", localConnector.Name, err)
+		}
+	}
+	return nil
+}
+
+func (h *serverConfigurationHandler) DeleteResource(resource utils.Resource) error {
+
+	return fmt.Errorf("governance connectors cannot be deleted")
+}
+
+// IsDeletable returns false since governance connectors cannot be deleted.
+func (h *serverConfigurationHandler) IsDeletable(resource utils.Resource) bool {
+
+	return false
+}
@@ -0,0 +1,108 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package serverconfigurations
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+type connectorCategory struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type connectorProperty struct {
+	Name     string `json:"name" yaml:"name"`
+	Value    string `json:"value" yaml:"value"`
+	ReadOnly bool   `json:"readOnly" yaml:"-"`
+}
+
+type connector struct {
+	Id           string              `json:"id" yaml:"-"`
+	Name         string              `json:"name" yaml:"name"`
+	FriendlyName string              `json:"friendlyName" yaml:"friendlyName"`
+	Properties   []connectorProperty `json:"properties" yaml:"properties"`
+}
+
+// ServerConfigurationConfig is the format of the server configuration files, where each file contains the governance
+// connectors of a connector category.
+type ServerConfigurationConfig struct {
+	Name       string      `yaml:"name"`
+	Connectors []connector `yaml:"connectors"`
+}
+
+func getCategoryList() ([]connectorCategory, error) {
+
+	body, _, err := utils.SendJsonRequest(http.MethodGet, getCategoryUrl(""), nil, utils.SERVER_CONFIGURATIONS)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve available governance connector categories. %w", err)
+	}
+	var categories []connectorCategory
+	if err := json.Unmarshal(body, &categories); err != nil {
+		return nil, fmt.Errorf("error when unmarshalling the retrieved governance connector categories. %w", err)
+	}
+	return categories, nil
+}
+
+func getConnectors(categoryId string) ([]connector, error) {
+
+	body, _, err := utils.SendJsonRequest(http.MethodGet, getCategoryUrl(categoryId), nil, utils.SERVER_CONFIGURATIONS)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve the governance connectors of the category. %w", err)
+	}
+	var connectors []connector
+	if err := json.Unmarshal(body, &connectors); err != nil {
+		return nil, fmt.Errorf("error when unmarshalling the retrieved governance connectors. %w", err)
+	}
+	return connectors, nil
+}
+
+func getCategoryUrl(categoryId string) string {
+
+	if categoryId == "" {
+		return utils.GetServerBaseUrl() + "/api/server/v1/identity-governance"
+	}
+	return utils.GetServerBaseUrl() + "/api/server/v1/identity-governance/" + categoryId
+}
+
+// patchConnector updates the given properties of the connector, leaving the other properties unchanged.
+func patchConnector(categoryId string, connectorId string, properties []connectorProperty) error {
+
+	var propertyValues []map[string]string
+	for _, property := range properties {
+		propertyValues = append(propertyValues, map[string]string{"name": property.Name, "value": property.Value})
+	}
+	body := map[string]interface{}{
+		"operation":  "UPDATE",
+		"properties": propertyValues,
+	}
+	connectorUrl := getCategoryUrl(categoryId) + "/connectors/" + connectorId
+	_, _, err := utils.SendJsonRequest(http.MethodPatch, connectorUrl, body, utils.SERVER_CONFIGURATIONS)
+	return err
+}
+
+func formatFileName(categoryName string) string {
+
+	return regexp.MustCompile(`[^\w\d-]+`).ReplaceAllString(categoryName, "_")
+}
@@ -0,0 +1,150 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package smstemplates
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+type smsTemplateHandler struct{}
+
+func init() {
+
+	utils.RegisterResourceHandler(&smsTemplateHandler{})
+}
+
+func (h *smsTemplateHandler) GetResourceType() string {
+
+	return utils.SMS_TEMPLATES
+}
+
+func (h *smsTemplateHandler) GetConfigKey() string {
+
+	return utils.SMS_TEMPLATES_CONFIG
+}
+
+func (h *smsTemplateHandler) GetDependencies() []string {
+
+	return nil
+}
+
+func (h *smsTemplateHandler) GetArrayIdentifiers() map[string]string {
+
+	return map[string]string{}
+}
+
+func (h *smsTemplateHandler) GetDeployedResources() ([]utils.Resource, error) {
+
+	return templateTypes.GetDeployedTemplates(getTemplateLocales)
+}
+
+func (h *smsTemplateHandler) GetFileName(resource utils.Resource) string {
+
+	return utils.FormatTemplateFileName(resource.Name)
+}
+
+func (h *smsTemplateHandler) ExportResource(resource utils.Resource, format string, excludeSecrets bool) (string, []byte, error) {
+
+	templateTypeId, locale := utils.SplitTemplateResourceId(resource.Id)
+	body, _, err := utils.SendJsonRequest(http.MethodGet, getTemplateUrl(templateTypeId, locale), nil, utils.SMS_TEMPLATES)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while exporting the SMS template: %s", err)
+	}
+	var template smsTemplate
+	if err := json.Unmarshal(body, &template); err != nil {
+		return "", nil, fmt.Errorf("error when unmarshalling the exported SMS template: %s", err)
+	}
+
+	templateConfig := SmsTemplateConfig{
+		TemplateType: strings.TrimSuffix(resource.Name, "_"+locale),
+		Locale:       locale,
+		Body:         template.Body,
+	}
+	content, err := yaml.Marshal(templateConfig)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while converting the exported SMS template: %s", err)
+	}
+	return utils.FormatTemplateFileName(resource.Name) + ".yml", content, nil
+}
+
+func (h *smsTemplateHandler) ResolveLocalResource(fileData []byte, fileInfo utils.FileInfo,
+	inventory *utils.ResourceInventory) (utils.Resource, error) {
+
+	var templateConfig SmsTemplateConfig
+	if err := yaml.Unmarshal(fileData, &templateConfig); err != nil {
+		return utils.Resource{}, fmt.Errorf("invalid file content for SMS template: %s. %s", fileInfo.ResourceName, err)
+	}
+	if templateConfig.TemplateType == "" || templateConfig.Locale == "" {
+		return utils.Resource{}, fmt.Errorf("templateType or locale is not defined for SMS template: %s", fileInfo.ResourceName)
+	}
+
+	resource := utils.Resource{Name: utils.GetTemplateResourceName(templateConfig.TemplateType, templateConfig.Locale)}
+	if template, ok := inventory.GetByName(resource.Name); ok {
+		resource.Id = template.Id
+	}
+	return resource, nil
+}
+
+func (h *smsTemplateHandler) ImportResource(resource utils.Resource, filePath string, fileData string) (string, error) {
+
+	var templateConfig SmsTemplateConfig
+	if err := yaml.Unmarshal([]byte(fileData), &templateConfig); err != nil {
+		return "", fmt.Errorf("invalid file content for SMS template: %s", err)
+	}
+	template := smsTemplate{Locale: templateConfig.Locale, Body: templateConfig.Body}
+	inventory, err := utils.GetInventory(h)
+	if err != nil {
+		return "", fmt.Errorf("error when retrieving the deployed templates. %s", err)
+	}
+	templateTypeId, err := createTemplate(templateConfig.TemplateType, template, inventory)
+	if err != nil {
+		return "", err
+	}
+	return utils.GetTemplateResourceId(templateTypeId, template.Locale), nil
+}
+
+func (h *smsTemplateHandler) UpdateResource(resource utils.Resource, filePath string, fileData string) error {
+
+	var templateConfig SmsTemplateConfig
+	if err := yaml.Unmarshal([]byte(fileData), &templateConfig); err != nil {
+		return fmt.Errorf("invalid file content for SMS template: %s", err)
+	}
+	templateTypeId, locale := utils.SplitTemplateResourceId(resource.Id)
+	body := map[string]string{"body": templateConfig.Body}
+	_, _, err := utils.SendJsonRequest(http.MethodPut, getTemplateUrl(templateTypeId, locale), body, utils.SMS_TEMPLATES)
+	return err
+}
+
+func (h *smsTemplateHandler) DeleteResource(resource utils.Resource) error {
+
+	templateTypeId, locale := utils.SplitTemplateResourceId(resource.Id)
+	_, _, err := utils.SendJsonRequest(http.MethodDelete, getTemplateUrl(templateTypeId, locale), nil, utils.SMS_TEMPLATES)
+	return err
+}
+
+func (h *smsTemplateHandler) IsDeletable(resource utils.Resource) bool {
+
+	return true
+}
@@ -0,0 +1,78 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package smstemplates
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+var templateTypes = utils.NewTemplateTypeManager("/api/server/v1/notification/sms/template-types", utils.SMS_TEMPLATES)
+
+type smsTemplate struct {
+	Locale string `json:"locale"`
+	Body   string `json:"body"`
+}
+
+// SmsTemplateConfig is the format of the SMS template files.
+type SmsTemplateConfig struct {
+	TemplateType string `yaml:"templateType"`
+	Locale       string `yaml:"locale"`
+	Body         string `yaml:"body"`
+}
+
+func getTemplateLocales(templateTypeId string) ([]string, error) {
+
+	body, _, err := utils.SendJsonRequest(http.MethodGet, getTemplateUrl(templateTypeId, ""), nil, utils.SMS_TEMPLATES)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve the SMS templates of the template type. %w", err)
+	}
+	var templates []smsTemplate
+	if err := json.Unmarshal(body, &templates); err != nil {
+		return nil, fmt.Errorf("error when unmarshalling the retrieved SMS templates. %w", err)
+	}
+	var locales []string
+	for _, template := range templates {
+		locales = append(locales, template.Locale)
+	}
+	return locales, nil
+}
+
+func getTemplateUrl(templateTypeId string, locale string) string {
+
+	if locale == "" {
+		return templateTypes.GetTemplateTypeUrl(templateTypeId) + "/org-templates"
+	}
+	return templateTypes.GetTemplateTypeUrl(templateTypeId) + "/org-templates/" + locale
+}
+
+// createTemplate adds the template to the template type, and creates the template type if it is not deployed.
+func createTemplate(templateTypeName string, template smsTemplate, inventory *utils.ResourceInventory) (string, error) {
+
+	body := map[string]string{"displayName": templateTypeName}
+	templateTypeId, _, err := templateTypes.ResolveTemplateType(templateTypeName, inventory, body)
+	if err != nil {
+		return "", err
+	}
+	_, _, err = utils.SendJsonRequest(http.MethodPost, getTemplateUrl(templateTypeId, ""), template, utils.SMS_TEMPLATES)
+	return templateTypeId, err
+}
@@ -0,0 +1,162 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package userstores
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strings"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+type userStoreHandler struct{}
+
+func init() {
+
+	utils.RegisterResourceHandler(&userStoreHandler{})
+}
+
+func (h *userStoreHandler) GetResourceType() string {
+
+	return utils.USERSTORES
+}
+
+func (h *userStoreHandler) GetConfigKey() string {
+
+	return utils.USERSTORES_CONFIG
+}
+
+func (h *userStoreHandler) GetDependencies() []string {
+
+	return []string{utils.CLAIMS}
+}
+
+func (h *userStoreHandler) GetArrayIdentifiers() map[string]string {
+
+	return utils.GetArrayIdentifiers(utils.USERSTORES)
+}
+
+func (h *userStoreHandler) GetDeployedResources() ([]utils.Resource, error) {
+
+	userstores, err := getUserStoreList()
+	if err != nil {
+		return nil, err
+	}
+	var resources []utils.Resource
+	for _, userstore := range userstores {
+		resources = append(resources, utils.Resource{Id: userstore.Id, Name: userstore.Name})
+	}
+	return resources, nil
+}
+
+func (h *userStoreHandler) GetFileName(resource utils.Resource) string {
+
+	return resource.Name
+}
+
+func (h *userStoreHandler) ExportResource(resource utils.Resource, format string, excludeSecrets bool) (string, []byte, error) {
+
+	if !excludeSecrets {
+		log.Println("Warn: Secrets exclusion cannot be disabled for userstores. All secrets will be masked.")
+	}
+	resp, err := utils.SendExportRequest(resource.Id, utils.GetFileType(format), utils.USERSTORES, true)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while exporting the user store: %s", err)
+	}
+	defer resp.Body.Close()
+
+	fileName, err := utils.GetExportedFileName(resp)
+	if err != nil {
+		return "", nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while reading the response body when exporting userstore: %s. %s", fileName, err)
+	}
+
+	// Use the common mask for senstive data.
+	return fileName, []byte(strings.ReplaceAll(string(body), USERSTORE_SECRET_MASK, utils.SENSITIVE_FIELD_MASK)), nil
+}
+
+func (h *userStoreHandler) ResolveLocalResource(fileData []byte, fileInfo utils.FileInfo,
+	inventory *utils.ResourceInventory) (utils.Resource, error) {
+
+	var userStoreConfig UserStoreConfigurations
+	err := yaml.Unmarshal(fileData, &userStoreConfig)
+	if err != nil {
+		return utils.Resource{}, fmt.Errorf("invalid file content for user store: %s. %s", fileInfo.ResourceName, err)
+	}
+
+	resource := utils.Resource{Name: userStoreConfig.Name}
+	if resource.Name == "" {
+		resource.Name = fileInfo.ResourceName
+	}
+	if userstore, ok := inventory.GetById(userStoreConfig.ID); ok {
+		resource.Id = userstore.Id
+	}
+	return resource, nil
+}
+
+func (h *userStoreHandler) ImportResource(resource utils.Resource, filePath string, fileData string) (string, error) {
+
+	return utils.SendImportRequest(filePath, fileData, utils.USERSTORES)
+}
+
+func (h *userStoreHandler) UpdateResource(resource utils.Resource, filePath string, fileData string) error {
+
+	return utils.SendUpdateRequest(resource.Id, filePath, fileData, utils.USERSTORES)
+}
+
+func (h *userStoreHandler) DeleteResource(resource utils.Resource) error {
+
+	return utils.SendDeleteRequest(resource.Id, utils.USERSTORES)
+}
+
+func (h *userStoreHandler) IsDeletable(resource utils.Resource) bool {
+
+	return true
+}
+
+// GetLocalReferenceNames returns the domain name of the user store in the local file.
+func (h *userStoreHandler) GetLocalReferenceNames(fileData []byte) ([]string, error) {
+
+	var userStoreConfig UserStoreConfigurations
+	if err := yaml.Unmarshal(fileData, &userStoreConfig); err != nil {
+		return nil, fmt.Errorf("invalid file content for user store. %s", err)
+	}
+	return []string{userStoreConfig.Name}, nil
+}
+
+// GetDeployedReferenceNames returns the domain names of the deployed user stores, including the primary user store.
+func (h *userStoreHandler) GetDeployedReferenceNames() ([]string, error) {
+
+	inventory, err := utils.GetInventory(h)
+	if err != nil {
+		return nil, err
+	}
+	names := []string{PRIMARY_DOMAIN}
+	for _, userStore := range inventory.List() {
+		names = append(names, userStore.Name)
+	}
+	return names, nil
+}
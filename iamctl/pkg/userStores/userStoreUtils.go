@@ -24,7 +24,6 @@ import (
 	"io/ioutil"
 
 	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
-	"gopkg.in/yaml.v2"
 )
 
 const USERSTORE_SECRET_MASK = "ENCRYPTED PROPERTY"
@@ -34,6 +33,9 @@ type userStore struct {
 	Name string `json:"name"`
 }
 
+// Domain name of the primary user store, which is not managed as a user store resource.
+const PRIMARY_DOMAIN = "PRIMARY"
+
 type UserStoreConfigurations struct {
 	Name string `yaml:"name"`
 	ID   string `yaml:"id"`
@@ -67,50 +69,3 @@ func getUserStoreList() ([]userStore, error) {
 	}
 	return nil, fmt.Errorf("unexpected error while retrieving userstore list")
 }
-
-func getDeployedUserstoreNames() []string {
-
-	userstores, err := getUserStoreList()
-	if err != nil {
-		return []string{}
-	}
-
-	var userstoreNames []string
-	for _, userstore := range userstores {
-		userstoreNames = append(userstoreNames, userstore.Name)
-	}
-	return userstoreNames
-}
-
-func getUserStoreKeywordMapping(userStoreName string) map[string]interface{} {
-
-	if utils.KEYWORD_CONFIGS.UserStoreConfigs != nil {
-		return utils.ResolveAdvancedKeywordMapping(userStoreName, utils.KEYWORD_CONFIGS.UserStoreConfigs)
-	}
-	return utils.KEYWORD_CONFIGS.KeywordMappings
-}
-
-func getUserStoreId(userStoreFilePath string) (string, error) {
-
-	fileContent, err := ioutil.ReadFile(userStoreFilePath)
-	if err != nil {
-		return "", fmt.Errorf("error when reading the file: %s. %s", userStoreFilePath, err)
-	}
-	var userStoreConfig UserStoreConfigurations
-	err = yaml.Unmarshal(fileContent, &userStoreConfig)
-	if err != nil {
-		return "", fmt.Errorf("invalid file content at: %s. %s", userStoreFilePath, err)
-	}
-
-	existingUserStoreList, err := getUserStoreList()
-	if err != nil {
-		return "", fmt.Errorf("error when retrieving the deployed userstore list: %s", err)
-	}
-
-	for _, userstore := range existingUserStoreList {
-		if userstore.Id == userStoreConfig.ID {
-			return userstore.Id, nil
-		}
-	}
-	return "", nil
-}
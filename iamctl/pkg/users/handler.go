@@ -0,0 +1,254 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package users
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+type userHandler struct{}
+
+func init() {
+
+	utils.RegisterResourceHandler(&userHandler{})
+}
+
+func (h *userHandler) GetResourceType() string {
+
+	return utils.USERS
+}
+
+func (h *userHandler) GetConfigKey() string {
+
+	return utils.USERS_CONFIG
+}
+
+func (h *userHandler) GetDependencies() []string {
+
+	return []string{utils.USERSTORES, utils.GROUPS}
+}
+
+func (h *userHandler) GetArrayIdentifiers() map[string]string {
+
+	return map[string]string{}
+}
+
+// IsExportEnabled returns false unless the export of users is enabled in the tool configs, since users are
+// seeded into the environments from the local files.
+func (h *userHandler) IsExportEnabled() bool {
+
+	return isExportEnabled(utils.GetResourceToolConfigs(h))
+}
+
+func (h *userHandler) SplitLocalFile(fileData []byte, fileInfo utils.FileInfo) ([][]byte, error) {
+
+	var usersData [][]byte
+	var err error
+	if strings.EqualFold(fileInfo.FileExtension, ".csv") {
+		usersData, err = splitCsvUsers(fileData)
+	} else {
+		usersData, err = splitYamlUsers(fileData)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid file content for users: %s. %s", fileInfo.FileName, err)
+	}
+	return usersData, nil
+}
+
+// GetDeployedResources returns the deployed users only if the export of users is enabled. Otherwise, the users defined
+// in the local files are looked up by the username when they are resolved, since listing all the users of a user store
+// does not scale.
+func (h *userHandler) GetDeployedResources() ([]utils.Resource, error) {
+
+	if !h.IsExportEnabled() {
+		return nil, nil
+	}
+	users, err := getUserList()
+	if err != nil {
+		return nil, err
+	}
+	var resources []utils.Resource
+	for _, user := range users {
+		resources = append(resources, utils.Resource{Id: user.Id, Name: user.UserName})
+	}
+	return resources, nil
+}
+
+func (h *userHandler) GetFileName(resource utils.Resource) string {
+
+	return formatFileName(resource.Name)
+}
+
+func (h *userHandler) ExportResource(resource utils.Resource, format string, excludeSecrets bool) (string, []byte, error) {
+
+	body, user, err := getUser(resource.Id)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while exporting the user: %s", err)
+	}
+	claims, err := getExportedClaims(body)
+	if err != nil {
+		return "", nil, err
+	}
+
+	domain, userName := splitQualifiedUserName(user.UserName)
+	userConfig := UserConfig{
+		Username: userName,
+		Domain:   domain,
+		Claims:   claims,
+		Groups:   []string{},
+	}
+	for _, group := range user.Groups {
+		userConfig.Groups = append(userConfig.Groups, group.Display)
+	}
+
+	content, err := yaml.Marshal(userConfig)
+	if err != nil {
+		return "", nil, fmt.Errorf("error while converting the exported user: %s", err)
+	}
+	return formatFileName(resource.Name) + ".yml", content, nil
+}
+
+func (h *userHandler) ResolveLocalResource(fileData []byte, fileInfo utils.FileInfo,
+	inventory *utils.ResourceInventory) (utils.Resource, error) {
+
+	userConfig, err := parseUserConfig(fileData)
+	if err != nil {
+		return utils.Resource{}, fmt.Errorf("invalid file content for users: %s. %s", fileInfo.FileName, err)
+	}
+	if userConfig.Username == "" {
+		return utils.Resource{}, fmt.Errorf("username is not defined for a user in: %s", fileInfo.FileName)
+	}
+	if err := validateDomain(userConfig.Domain); err != nil {
+		return utils.Resource{}, fmt.Errorf("invalid domain for user: %s. %s", userConfig.Username, err)
+	}
+
+	resource := utils.Resource{Name: getQualifiedUserName(userConfig)}
+	if user, ok := inventory.GetByName(resource.Name); ok {
+		resource.Id = user.Id
+	} else if !h.IsExportEnabled() {
+		if resource.Id, err = getUserId(resource.Name); err != nil {
+			return utils.Resource{}, fmt.Errorf("error when retrieving the user: %s. %s", resource.Name, err)
+		}
+		if resource.Id != "" {
+			inventory.Put(resource)
+		}
+	}
+	return resource, nil
+}
+
+func (h *userHandler) ImportResource(resource utils.Resource, filePath string, fileData string) (string, error) {
+
+	userConfig, err := parseUserConfig([]byte(fileData))
+	if err != nil {
+		return "", fmt.Errorf("invalid file content for user: %s", err)
+	}
+	password, generated, err := resolvePassword(userConfig, utils.GetResourceToolConfigs(h))
+	if err != nil {
+		return "", err
+	}
+
+	body := map[string]interface{}{}
+	for claim, value := range userConfig.Claims {
+		body[claim] = value
+	}
+	body["schemas"] = []string{USER_SCHEMA}
+	body["userName"] = resource.Name
+	body["password"] = password
+
+	// Write the generated password before creating the user, so that a user is never created with an unknown password.
+	if generated {
+		if err := reportGeneratedPassword(resource.Name, password, utils.GetResourceToolConfigs(h)); err != nil {
+			return "", err
+		}
+	}
+	respBody, _, err := utils.SendJsonRequest(http.MethodPost, getUserUrl(""), body, utils.USERS)
+	if err != nil {
+		return "", err
+	}
+
+	var createdUser user
+	if err := json.Unmarshal(respBody, &createdUser); err != nil || createdUser.Id == "" {
+		// Resolve the ID of the created user, so that the user can be updated and reverted.
+		if createdUser.Id, err = getUserId(resource.Name); err != nil || createdUser.Id == "" {
+			return "", fmt.Errorf("user created, but the groups are not assigned since the user ID is not returned")
+		}
+	}
+	if err := syncGroups(createdUser.Id, resource.Name, userConfig.Groups, nil); err != nil {
+		return createdUser.Id, err
+	}
+	return createdUser.Id, nil
+}
+
+func (h *userHandler) UpdateResource(resource utils.Resource, filePath string, fileData string) error {
+
+	userConfig, err := parseUserConfig([]byte(fileData))
+	if err != nil {
+		return fmt.Errorf("invalid file content for user: %s", err)
+	}
+	if strings.Contains(userConfig.Password, "{{") {
+		return fmt.Errorf("keyword mapping is not defined for the password of user: %s", userConfig.Username)
+	}
+
+	// Patch the claims so that the password of the user is changed only if it is defined in the file.
+	value := map[string]interface{}{}
+	for claim, claimValue := range userConfig.Claims {
+		value[claim] = claimValue
+	}
+	if userConfig.Password != "" {
+		value["password"] = userConfig.Password
+	}
+	if len(value) > 0 {
+		body := map[string]interface{}{
+			"schemas": []string{PATCH_OP_SCHEMA},
+			"Operations": []interface{}{
+				map[string]interface{}{"op": "replace", "value": value},
+			},
+		}
+		if _, _, err := utils.SendJsonRequest(http.MethodPatch, getUserUrl(resource.Id), body, utils.USERS); err != nil {
+			return err
+		}
+	}
+
+	if userConfig.Groups == nil {
+		return nil
+	}
+	_, user, err := getUser(resource.Id)
+	if err != nil {
+		return err
+	}
+	return syncGroups(resource.Id, resource.Name, userConfig.Groups, user.Groups)
+}
+
+func (h *userHandler) DeleteResource(resource utils.Resource) error {
+
+	_, _, err := utils.SendJsonRequest(http.MethodDelete, getUserUrl(resource.Id), nil, utils.USERS)
+	return err
+}
+
+// IsDeletable returns false since users that are not defined in the local files are never deleted by the tool.
+func (h *userHandler) IsDeletable(resource utils.Resource) bool {
+
+	return false
+}
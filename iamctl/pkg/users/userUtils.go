@@ -0,0 +1,477 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package users
+
+import (
+	"crypto/rand"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+const USERS_ENDPOINT = "Users"
+const GROUPS_ENDPOINT = "Groups"
+const USER_SCHEMA = "urn:ietf:params:scim:schemas:core:2.0:User"
+const PATCH_OP_SCHEMA = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+const PRIMARY_DOMAIN = "PRIMARY"
+const CSV_VALUE_SEPARATOR = ";"
+const GENERATED_PASSWORD_LENGTH = 16
+
+// Fields of the SCIM2 user that are not exported as claims.
+var nonClaimFields = []string{"id", "meta", "schemas", "userName", "password", "groups", "roles"}
+
+// Columns of the CSV user files that are not claims.
+var nonClaimColumns = []string{"username", "password", "domain", "groups"}
+
+var passwordCharacterSets = []string{"ABCDEFGHIJKLMNOPQRSTUVWXYZ", "abcdefghijklmnopqrstuvwxyz", "0123456789", "!@#$%&*"}
+
+var passwordFileMutex sync.Mutex
+
+type scimReference struct {
+	Value   string `json:"value"`
+	Display string `json:"display"`
+}
+
+type user struct {
+	Id       string          `json:"id"`
+	UserName string          `json:"userName"`
+	Groups   []scimReference `json:"groups"`
+}
+
+// UserConfig is the format of a user in the user files. Claims are given as SCIM2 user attributes.
+type UserConfig struct {
+	Username string                 `yaml:"username"`
+	Password string                 `yaml:"password,omitempty"`
+	Domain   string                 `yaml:"domain,omitempty"`
+	Claims   map[string]interface{} `yaml:"claims,omitempty"`
+	Groups   []string               `yaml:"groups"`
+}
+
+type userFile struct {
+	Users []interface{} `yaml:"users"`
+}
+
+func getUserList() ([]user, error) {
+
+	resources, err := utils.GetScimResources(USERS_ENDPOINT, "userName", utils.USERS)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []user
+	for _, resource := range resources {
+		var u user
+		if err := json.Unmarshal(resource, &u); err != nil {
+			return nil, fmt.Errorf("error when unmarshalling the retrieved user. %w", err)
+		}
+		users = append(users, u)
+	}
+	return users, nil
+}
+
+// getUserId returns the ID of the deployed user with the given username, or an empty ID if the user does not exist.
+func getUserId(userName string) (string, error) {
+
+	filter := fmt.Sprintf("userName eq \"%s\"", strings.ReplaceAll(userName, "\"", "\\\""))
+	return utils.GetScimResourceId(USERS_ENDPOINT, filter, utils.USERS)
+}
+
+func getUserUrl(userId string) string {
+
+	if userId == "" {
+		return utils.GetScimBaseUrl() + USERS_ENDPOINT
+	}
+	return utils.GetScimBaseUrl() + USERS_ENDPOINT + "/" + userId
+}
+
+func getUser(userId string) ([]byte, user, error) {
+
+	var u user
+	body, _, err := utils.SendJsonRequest(http.MethodGet, getUserUrl(userId), nil, utils.USERS)
+	if err != nil {
+		return nil, u, err
+	}
+	if err := json.Unmarshal(body, &u); err != nil {
+		return nil, u, fmt.Errorf("error when unmarshalling the retrieved user. %w", err)
+	}
+	return body, u, nil
+}
+
+func parseUserConfig(fileData []byte) (UserConfig, error) {
+
+	var userConfig UserConfig
+	if err := yaml.Unmarshal(fileData, &userConfig); err != nil {
+		return userConfig, err
+	}
+	claims, err := utils.YamlToMap(fileData)
+	if err != nil {
+		return userConfig, err
+	}
+	if userClaims, ok := claims["claims"].(map[string]interface{}); ok {
+		userConfig.Claims = userClaims
+	}
+	return userConfig, nil
+}
+
+// getQualifiedUserName returns the username with the user store domain, as used by the SCIM2 API.
+func getQualifiedUserName(userConfig UserConfig) string {
+
+	domain := strings.ToUpper(userConfig.Domain)
+	if domain == "" || domain == PRIMARY_DOMAIN {
+		return userConfig.Username
+	}
+	return domain + "/" + userConfig.Username
+}
+
+// splitQualifiedUserName returns the user store domain and the username of a qualified username.
+func splitQualifiedUserName(userName string) (string, string) {
+
+	if index := strings.Index(userName, "/"); index > 0 {
+		return userName[:index], userName[index+1:]
+	}
+	return PRIMARY_DOMAIN, userName
+}
+
+// validateDomain checks whether the user store domain of the user is deployed in the target environment.
+func validateDomain(domain string) error {
+
+	if domain == "" || strings.EqualFold(domain, PRIMARY_DOMAIN) {
+		return nil
+	}
+	userStoreHandler := utils.GetResourceHandler(utils.USERSTORES)
+	if userStoreHandler == nil {
+		return fmt.Errorf("user stores are not supported by the tool")
+	}
+	userStoreInventory, err := utils.GetInventory(userStoreHandler)
+	if err != nil {
+		return fmt.Errorf("error when retrieving the deployed user stores. %s", err)
+	}
+	for _, userStore := range userStoreInventory.List() {
+		if strings.EqualFold(userStore.Name, domain) {
+			return nil
+		}
+	}
+	return fmt.Errorf("user store: %s not found in the target environment", domain)
+}
+
+// splitYamlUsers returns the content of each user defined under the users key of a YAML user file.
+// A file without the users key defines a single user.
+func splitYamlUsers(fileData []byte) ([][]byte, error) {
+
+	var file userFile
+	if err := yaml.Unmarshal(fileData, &file); err != nil {
+		return nil, err
+	}
+	if file.Users == nil {
+		return [][]byte{fileData}, nil
+	}
+
+	var usersData [][]byte
+	for _, u := range file.Users {
+		userData, err := yaml.Marshal(u)
+		if err != nil {
+			return nil, err
+		}
+		usersData = append(usersData, userData)
+	}
+	return usersData, nil
+}
+
+// splitCsvUsers converts each row of a CSV user file to the YAML user format. Columns other than username, password,
+// domain and groups are added as claims, where the column name is the SCIM2 attribute path (ex: name.givenName).
+func splitCsvUsers(fileData []byte) ([][]byte, error) {
+
+	records, err := csv.NewReader(strings.NewReader(string(fileData))).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	header := records[0]
+	var usersData [][]byte
+	for _, record := range records[1:] {
+		userConfig := UserConfig{Claims: map[string]interface{}{}}
+		for i, column := range header {
+			value := strings.TrimSpace(record[i])
+			if strings.EqualFold(strings.TrimSpace(column), "groups") {
+				// An empty groups value removes the user from all groups, unlike a missing groups column.
+				userConfig.Groups = append([]string{}, splitCsvValue(value)...)
+				continue
+			}
+			if value == "" {
+				continue
+			}
+			switch strings.ToLower(strings.TrimSpace(column)) {
+			case "username":
+				userConfig.Username = value
+			case "password":
+				userConfig.Password = value
+			case "domain":
+				userConfig.Domain = value
+			default:
+				setClaimValue(userConfig.Claims, strings.Split(strings.TrimSpace(column), "."), getCsvClaimValue(value))
+			}
+		}
+		userData, err := marshalUserConfig(userConfig)
+		if err != nil {
+			return nil, err
+		}
+		usersData = append(usersData, userData)
+	}
+	return usersData, nil
+}
+
+// marshalUserConfig converts the user to the YAML user format, omitting the groups key if the groups are not defined so
+// that the group memberships of the user are left unchanged.
+func marshalUserConfig(userConfig UserConfig) ([]byte, error) {
+
+	userData, err := yaml.Marshal(userConfig)
+	if err != nil || userConfig.Groups != nil {
+		return userData, err
+	}
+	var userFields yaml.MapSlice
+	if err := yaml.Unmarshal(userData, &userFields); err != nil {
+		return nil, err
+	}
+	var definedFields yaml.MapSlice
+	for _, field := range userFields {
+		if field.Key != "groups" {
+			definedFields = append(definedFields, field)
+		}
+	}
+	return yaml.Marshal(definedFields)
+}
+
+func splitCsvValue(value string) []string {
+
+	var values []string
+	for _, item := range strings.Split(value, CSV_VALUE_SEPARATOR) {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+	return values
+}
+
+// getCsvClaimValue returns a list for multi-valued claims, which are separated by semicolons in the CSV files.
+func getCsvClaimValue(value string) interface{} {
+
+	if strings.Contains(value, CSV_VALUE_SEPARATOR) {
+		return splitCsvValue(value)
+	}
+	return value
+}
+
+func setClaimValue(claims map[string]interface{}, path []string, value interface{}) {
+
+	if len(path) == 1 {
+		claims[path[0]] = value
+		return
+	}
+	child, ok := claims[path[0]].(map[string]interface{})
+	if !ok {
+		child = map[string]interface{}{}
+		claims[path[0]] = child
+	}
+	setClaimValue(child, path[1:], value)
+}
+
+// getExportedClaims returns the attributes of the retrieved user that are exported as claims.
+func getExportedClaims(userBody []byte) (map[string]interface{}, error) {
+
+	var claims map[string]interface{}
+	if err := json.Unmarshal(userBody, &claims); err != nil {
+		return nil, fmt.Errorf("error when unmarshalling the retrieved user. %w", err)
+	}
+	for _, field := range nonClaimFields {
+		delete(claims, field)
+	}
+	return claims, nil
+}
+
+// resolvePassword returns the password of the user, or a generated password if a password is not defined. A password
+// is generated only if the file to write the generated passwords is configured.
+func resolvePassword(userConfig UserConfig, resourceConfigs map[string]interface{}) (string, bool, error) {
+
+	if strings.Contains(userConfig.Password, "{{") {
+		return "", false, fmt.Errorf("keyword mapping is not defined for the password of user: %s", userConfig.Username)
+	}
+	if userConfig.Password != "" {
+		return userConfig.Password, false, nil
+	}
+	if getGeneratedPasswordsFile(resourceConfigs) == "" {
+		return "", false, fmt.Errorf("password is not defined for user: %s, and the %s config is not set to generate one",
+			userConfig.Username, utils.GENERATED_PASSWORDS_FILE_CONFIG)
+	}
+	password, err := generatePassword()
+	return password, true, err
+}
+
+func getGeneratedPasswordsFile(resourceConfigs map[string]interface{}) string {
+
+	if filePath, ok := resourceConfigs[utils.GENERATED_PASSWORDS_FILE_CONFIG].(string); ok {
+		return filePath
+	}
+	return ""
+}
+
+func generatePassword() (string, error) {
+
+	// Include a character from each character set to satisfy the default password policy.
+	var password []byte
+	allCharacters := strings.Join(passwordCharacterSets, "")
+	for i := 0; i < GENERATED_PASSWORD_LENGTH; i++ {
+		characters := allCharacters
+		if i < len(passwordCharacterSets) {
+			characters = passwordCharacterSets[i]
+		}
+		index, err := rand.Int(rand.Reader, big.NewInt(int64(len(characters))))
+		if err != nil {
+			return "", fmt.Errorf("error when generating the password. %s", err)
+		}
+		password = append(password, characters[index.Int64()])
+	}
+
+	// Shuffle the password so that the character types are not in a fixed order.
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", fmt.Errorf("error when generating the password. %s", err)
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+	return string(password), nil
+}
+
+// reportGeneratedPassword appends the generated password of a user to the generated passwords file.
+func reportGeneratedPassword(userName string, password string, resourceConfigs map[string]interface{}) error {
+
+	filePath := getGeneratedPasswordsFile(resourceConfigs)
+
+	passwordFileMutex.Lock()
+	defer passwordFileMutex.Unlock()
+
+	file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("error when writing the generated password to file: %s. %s", filePath, err)
+	}
+	defer file.Close()
+	// Restrict the permissions of a file that already existed as well, since it contains the passwords in plain text.
+	if err := file.Chmod(0600); err != nil {
+		return fmt.Errorf("error when restricting the permissions of file: %s. %s", filePath, err)
+	}
+
+	writer := csv.NewWriter(file)
+	if err := writer.Write([]string{userName, password}); err != nil {
+		return fmt.Errorf("error when writing the generated password to file: %s. %s", filePath, err)
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("error when writing the generated password to file: %s. %s", filePath, err)
+	}
+	log.Printf("Password generated for user: %s. The password is written to the file: %s", userName, filePath)
+	return nil
+}
+
+func isExportEnabled(resourceConfigs map[string]interface{}) bool {
+
+	if enableExport, ok := resourceConfigs[utils.ENABLE_EXPORT_CONFIG].(bool); ok {
+		return enableExport
+	}
+	return false
+}
+
+// syncGroups adds the user to the given groups and removes the user from the groups that are not given. The group
+// memberships are left unchanged if the groups are not defined (nil).
+func syncGroups(userId string, userName string, groupNames []string, assignedGroups []scimReference) error {
+
+	if groupNames == nil {
+		return nil
+	}
+	groupHandler := utils.GetResourceHandler(utils.GROUPS)
+	if groupHandler == nil {
+		return fmt.Errorf("groups are not supported by the tool")
+	}
+	groupInventory, err := utils.GetInventory(groupHandler)
+	if err != nil {
+		return fmt.Errorf("error when retrieving the deployed groups. %s", err)
+	}
+
+	var assignedGroupIds []string
+	for _, assignedGroup := range assignedGroups {
+		assignedGroupIds = append(assignedGroupIds, assignedGroup.Value)
+	}
+
+	var groupIds []string
+	for _, groupName := range groupNames {
+		group, ok := groupInventory.GetByName(groupName)
+		if !ok {
+			return fmt.Errorf("group: %s not found in the target environment", groupName)
+		}
+		groupIds = append(groupIds, group.Id)
+		if !utils.Contains(assignedGroupIds, group.Id) {
+			if err := patchGroupMembers(group.Id, "add", userId, userName); err != nil {
+				return fmt.Errorf("error when adding the user to group: %s. %s", groupName, err)
+			}
+		}
+	}
+	for _, assignedGroup := range assignedGroups {
+		if !utils.Contains(groupIds, assignedGroup.Value) {
+			if err := patchGroupMembers(assignedGroup.Value, "remove", userId, userName); err != nil {
+				return fmt.Errorf("error when removing the user from group: %s. %s", assignedGroup.Display, err)
+			}
+		}
+	}
+	return nil
+}
+
+func patchGroupMembers(groupId string, operation string, userId string, userName string) error {
+
+	patchOperation := map[string]interface{}{"op": operation}
+	if operation == "add" {
+		patchOperation["value"] = map[string]interface{}{
+			"members": []map[string]string{{"value": userId, "display": userName}},
+		}
+	} else {
+		patchOperation["path"] = fmt.Sprintf("members[value eq %s]", userId)
+	}
+	body := map[string]interface{}{
+		"schemas":    []string{PATCH_OP_SCHEMA},
+		"Operations": []interface{}{patchOperation},
+	}
+	_, _, err := utils.SendJsonRequest(http.MethodPatch, utils.GetScimBaseUrl()+GROUPS_ENDPOINT+"/"+groupId, body, utils.USERS)
+	return err
+}
+
+func formatFileName(userName string) string {
+
+	// Usernames of secondary user stores contain the user store domain separated by a slash.
+	return strings.ReplaceAll(userName, "/", "_")
+}
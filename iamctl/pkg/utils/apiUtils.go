@@ -20,7 +20,6 @@ package utils
 
 import (
 	"bytes"
-	"crypto/tls"
 	"fmt"
 	"io"
 	"log"
@@ -29,6 +28,7 @@ import (
 	"net/http"
 	"net/textproto"
 	"net/url"
+	"path"
 	"strconv"
 	"strings"
 )
@@ -48,7 +48,7 @@ func SendExportRequest(resourceId, fileType, resourceType string, excludeSecrets
 	}
 	req.Header.Set("Content-Type", MEDIA_TYPE_FORM)
 	req.Header.Set("accept", fileType)
-	req.Header.Set("Authorization", "Bearer "+SERVER_CONFIGS.Token)
+	req.Header.Set("Authorization", "Bearer "+currentAccessToken())
 
 	query := req.URL.Query()
 	if resourceType == APPLICATIONS {
@@ -61,15 +61,7 @@ func SendExportRequest(resourceId, fileType, resourceType string, excludeSecrets
 
 	defer req.Body.Close()
 
-	httpClient := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
-	}
-
-	resp, err = httpClient.Do(req)
+	resp, err = sendRequest(req, resourceType)
 	if err != nil {
 		return resp, fmt.Errorf("error while exporting resource: %s", err)
 	}
@@ -83,7 +75,7 @@ func SendExportRequest(resourceId, fileType, resourceType string, excludeSecrets
 	return resp, fmt.Errorf("unexpected error while exporting the resource with status code: %s", strconv.FormatInt(int64(statusCode), 10))
 }
 
-func SendImportRequest(importFilePath, fileData, resourceType string) error {
+func SendImportRequest(importFilePath, fileData, resourceType string) (string, error) {
 
 	reqUrl := buildRequestUrl(IMPORT, resourceType, "")
 
@@ -91,7 +83,7 @@ func SendImportRequest(importFilePath, fileData, resourceType string) error {
 	var err error
 	_, err = io.WriteString(&buf, fileData)
 	if err != nil {
-		return fmt.Errorf("error when creating the import request: %s", err)
+		return "", fmt.Errorf("error when creating the import request: %s", err)
 	}
 
 	mime.AddExtensionType(".yml", "application/yaml")
@@ -110,41 +102,35 @@ func SendImportRequest(importFilePath, fileData, resourceType string) error {
 		"Content-Type":        []string{mimeType},
 	})
 	if err != nil {
-		return fmt.Errorf("error when creating the import request: %s", err)
+		return "", fmt.Errorf("error when creating the import request: %s", err)
 	}
 
 	_, err = io.Copy(part, &buf)
 	if err != nil {
-		return fmt.Errorf("error when creating the import request: %s", err)
+		return "", fmt.Errorf("error when creating the import request: %s", err)
 	}
 
 	request, err := http.NewRequest("POST", reqUrl, body)
 	request.Header.Add("Content-Type", writer.FormDataContentType())
-	request.Header.Set("Authorization", "Bearer "+SERVER_CONFIGS.Token)
+	request.Header.Set("Authorization", "Bearer "+currentAccessToken())
 	defer request.Body.Close()
 
 	if err != nil {
-		return fmt.Errorf("error when creating the import request: %s", err)
-	}
-	client := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
+		return "", fmt.Errorf("error when creating the import request: %s", err)
 	}
-	resp, err := client.Do(request)
+	resp, err := sendRequest(request, resourceType)
 	if err != nil {
-		return fmt.Errorf("error when sending the import request: %s", err)
+		return "", fmt.Errorf("error when sending the import request: %s", err)
 	}
+	defer resp.Body.Close()
 
 	statusCode := resp.StatusCode
 	if statusCode == 201 {
-		return nil
+		return getCreatedResourceId(resp), nil
 	} else if error, ok := ErrorCodes[statusCode]; ok {
-		return fmt.Errorf("error response for the import request: %s", error)
+		return "", fmt.Errorf("error response for the import request: %s", error)
 	}
-	return fmt.Errorf("unexpected error when importing resource: %s", resp.Status)
+	return "", fmt.Errorf("unexpected error when importing resource: %s", resp.Status)
 }
 
 func SendUpdateRequest(resourceId, importFilePath, fileData, resourceType string) error {
@@ -185,23 +171,17 @@ func SendUpdateRequest(resourceId, importFilePath, fileData, resourceType string
 
 	request, err := http.NewRequest("PUT", formattedReqUrl, body)
 	request.Header.Add("Content-Type", writer.FormDataContentType())
-	request.Header.Set("Authorization", "Bearer "+SERVER_CONFIGS.Token)
+	request.Header.Set("Authorization", "Bearer "+currentAccessToken())
 	defer request.Body.Close()
 
 	if err != nil {
 		return fmt.Errorf("error when creating the import request: %s", err)
 	}
-	client := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
-	}
-	resp, err := client.Do(request)
+	resp, err := sendRequest(request, resourceType)
 	if err != nil {
 		return fmt.Errorf("error when sending the import request: %s", err)
 	}
+	defer resp.Body.Close()
 
 	statusCode := resp.StatusCode
 
@@ -219,24 +199,18 @@ func SendDeleteRequest(resourceId string, resourceType string) error {
 
 	reqUrl := buildRequestUrl(DELETE, resourceType, resourceId)
 	request, err := http.NewRequest("DELETE", reqUrl, bytes.NewBuffer(nil))
-	request.Header.Set("Authorization", "Bearer "+SERVER_CONFIGS.Token)
+	request.Header.Set("Authorization", "Bearer "+currentAccessToken())
 	defer request.Body.Close()
 
 	if err != nil {
 		return fmt.Errorf("error when creating the delete request: %s", err)
 	}
 
-	client := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
-	}
-	resp, err := client.Do(request)
+	resp, err := sendRequest(request, resourceType)
 	if err != nil {
 		return fmt.Errorf("error when sending the delete request: %s", err)
 	}
+	defer resp.Body.Close()
 
 	statusCode := resp.StatusCode
 	if statusCode == 204 {
@@ -251,10 +225,9 @@ func SendDeleteRequest(resourceId string, resourceType string) error {
 func SendGetListRequest(resourceType string, resourceLimit int) (*http.Response, error) {
 
 	var reqUrl = buildRequestUrl(LIST, resourceType, "")
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
 
 	req, _ := http.NewRequest("GET", reqUrl, bytes.NewBuffer(nil))
-	req.Header.Set("Authorization", "Bearer "+SERVER_CONFIGS.Token)
+	req.Header.Set("Authorization", "Bearer "+currentAccessToken())
 	req.Header.Set("accept", "*/*")
 
 	if resourceLimit != -1 {
@@ -264,8 +237,7 @@ func SendGetListRequest(resourceType string, resourceLimit int) (*http.Response,
 	}
 	defer req.Body.Close()
 
-	httpClient := &http.Client{}
-	resp, err := httpClient.Do(req)
+	resp, err := sendRequest(req, resourceType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve available userstore list. %w", err)
 	}
@@ -289,7 +261,7 @@ func getResourcePath(resourceType string) string {
 
 func getResourceBaseUrl(resourceType string) string {
 
-	return SERVER_CONFIGS.ServerUrl + "/t/" + SERVER_CONFIGS.TenantDomain + "/api/server/v1/" + getResourcePath(resourceType) + "/"
+	return GetServerBaseUrl() + "/api/server/v1/" + getResourcePath(resourceType) + "/"
 }
 
 func buildRequestUrl(requestType, resourceType, resourceId string) (reqUrl string) {
@@ -337,3 +309,13 @@ func addQueryParams(reqURL, resourceType string) string {
 	url.RawQuery = queryParams.Encode()
 	return url.String()
 }
+
+// getCreatedResourceId returns the ID of the created resource from the location header of the response.
+func getCreatedResourceId(resp *http.Response) string {
+
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return ""
+	}
+	return path.Base(strings.TrimSuffix(location, "/"))
+}
@@ -0,0 +1,88 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+)
+
+// getExportedAttachments extracts the attachments from the exported content and returns them by their file paths in the
+// output directory. The content of a local attachment is kept if it only differs from the exported attachment by the
+// keyword placeholders added to it.
+func getExportedAttachments(handler AttachmentHandler, resource Resource, fileName string, content []byte,
+	outputDirPath string, keywordMapping map[string]interface{}) ([]byte, map[string][]byte, error) {
+
+	content, attachments, err := handler.ExtractAttachments(resource, fileName, content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while extracting the attachments of the exported content: %s", err)
+	}
+
+	exportedAttachments := make(map[string][]byte)
+	for attachmentName, attachment := range attachments {
+		attachmentPath := filepath.Join(outputDirPath, attachmentName)
+		localAttachment, err := ioutil.ReadFile(attachmentPath)
+		if err == nil && isAttachmentInSync(localAttachment, attachment, keywordMapping) {
+			attachment = localAttachment
+		}
+		exportedAttachments[attachmentPath] = attachment
+	}
+	return content, exportedAttachments, nil
+}
+
+func isAttachmentInSync(localAttachment []byte, deployedAttachment []byte, keywordMapping map[string]interface{}) bool {
+
+	return ReplaceKeywords(string(localAttachment), keywordMapping) == ReplaceKeywords(string(deployedAttachment), keywordMapping)
+}
+
+// IsAttachmentFile returns true if the given local file is an attachment of another resource file of the handler.
+func IsAttachmentFile(handler ResourceHandler, fileName string) bool {
+
+	if attachmentHandler, ok := handler.(AttachmentHandler); ok {
+		return attachmentHandler.IsAttachment(fileName)
+	}
+	return false
+}
+
+// isAttachmentOfResources returns true if the given local file is an attachment of one of the given resource files.
+func isAttachmentOfResources(handler ResourceHandler, fileName string, resourceFileNames []string) bool {
+
+	if !IsAttachmentFile(handler, fileName) {
+		return false
+	}
+	for _, resourceFileName := range resourceFileNames {
+		if strings.HasPrefix(strings.ToLower(fileName), strings.ToLower(resourceFileName)+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadAttachment reads an attachment of a local resource file and replaces the keyword placeholders in it.
+func ReadAttachment(handler ResourceHandler, resourceFilePath string, attachmentName string) (string, error) {
+
+	attachment, err := ioutil.ReadFile(filepath.Join(filepath.Dir(resourceFilePath), attachmentName))
+	if err != nil {
+		return "", fmt.Errorf("error when reading the attachment: %s. %s", attachmentName, err)
+	}
+	keywordMapping := GetResourceKeywordMapping(handler, GetFileInfo(resourceFilePath).ResourceName)
+	return ReplaceKeywords(string(attachment), keywordMapping), nil
+}
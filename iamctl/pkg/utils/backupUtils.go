@@ -0,0 +1,349 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const BACKUP_FILE_PREFIX = "iamctl-backup-"
+const BACKUP_FILE_EXTENSION = ".tar.gz"
+const BACKUP_MANIFEST_FILE = "manifest.json"
+const BACKUP_TIME_FORMAT = "20060102-150405"
+
+// Include secrets in the exported resources regardless of the tool configs, such as when taking backups.
+var INCLUDE_SECRETS bool
+
+// BackupManifest describes the content of a backup archive, so that it can be verified before restoring.
+type BackupManifest struct {
+	ServerUrl      string    `json:"serverUrl"`
+	TenantDomain   string    `json:"tenantDomain"`
+	OrganizationId string    `json:"organizationId,omitempty"`
+	CreatedAt      time.Time `json:"createdAt"`
+	ToolVersion    string    `json:"toolVersion"`
+	// SHA-256 checksums of the files in the archive by their paths.
+	Files map[string]string `json:"files"`
+}
+
+// BackupResources exports all resources of the target environment, including the secrets where the server returns
+// them, into a new backup archive in the given directory and returns the path of the archive.
+func BackupResources(backupDirPath string) (string, error) {
+
+	tempDirPath, err := ioutil.TempDir("", "iamctl-backup")
+	if err != nil {
+		return "", fmt.Errorf("error when creating a temporary directory: %s", err)
+	}
+	defer os.RemoveAll(tempDirPath)
+
+	// Export every resource as deployed, regardless of the filters and keyword mappings in the configs.
+	defaultToolConfigs, defaultKeywordConfigs := TOOL_CONFIGS, KEYWORD_CONFIGS
+	TOOL_CONFIGS = getBackupToolConfigs(defaultToolConfigs)
+	KEYWORD_CONFIGS = KeywordConfigs{}
+	INCLUDE_SECRETS = true
+	defer func() {
+		TOOL_CONFIGS, KEYWORD_CONFIGS = defaultToolConfigs, defaultKeywordConfigs
+		INCLUDE_SECRETS = false
+	}()
+
+	failedOperations := getFailedOperationCount()
+	ExportAllResources(tempDirPath, SNAPSHOT_FORMAT)
+	if failedExports := getFailedOperationCount() - failedOperations; failedExports > 0 {
+		return "", fmt.Errorf("the backup archive is not created since %d of the exports failed", failedExports)
+	}
+
+	manifest := BackupManifest{
+		ServerUrl:      SERVER_CONFIGS.ServerUrl,
+		TenantDomain:   SERVER_CONFIGS.TenantDomain,
+		OrganizationId: SERVER_CONFIGS.OrganizationId,
+		CreatedAt:      time.Now().UTC(),
+		ToolVersion:    TOOL_VERSION,
+	}
+	if err := os.MkdirAll(backupDirPath, 0700); err != nil {
+		return "", fmt.Errorf("error when creating the backup directory: %s", err)
+	}
+	archivePath := filepath.Join(backupDirPath, getBackupFileName(manifest.TenantDomain, manifest.CreatedAt))
+	return archivePath, WriteBackupArchive(tempDirPath, archivePath, manifest)
+}
+
+// getBackupToolConfigs returns the tool configs used to take a backup, which export every resource of every resource
+// type while keeping the request settings of the given tool configs.
+func getBackupToolConfigs(toolConfigs ToolConfigs) ToolConfigs {
+
+	backupToolConfigs := ToolConfigs{
+		MaxRetryAttempts: toolConfigs.MaxRetryAttempts,
+		MaxRetryBackoff:  toolConfigs.MaxRetryBackoff,
+		RequestTimeout:   toolConfigs.RequestTimeout,
+		ResourceConfigs:  make(map[string]map[string]interface{}),
+	}
+	for _, handler := range GetResourceHandlers() {
+		backupToolConfigs.ResourceConfigs[handler.GetConfigKey()] = map[string]interface{}{ENABLE_EXPORT_CONFIG: true}
+	}
+	return backupToolConfigs
+}
+
+// RestoreBackup verifies the given backup archive and imports the resources in it to the target environment. A backup
+// taken from a different environment is only restored if forced.
+func RestoreBackup(archivePath string, force bool) error {
+
+	tempDirPath, err := ioutil.TempDir("", "iamctl-restore")
+	if err != nil {
+		return fmt.Errorf("error when creating a temporary directory: %s", err)
+	}
+	defer os.RemoveAll(tempDirPath)
+
+	manifest, err := ExtractBackupArchive(archivePath, tempDirPath)
+	if err != nil {
+		return err
+	}
+	log.Printf("Restoring the backup of %s (tenant: %s) created at %s with iamctl version %s.", manifest.ServerUrl,
+		manifest.TenantDomain, manifest.CreatedAt.Format(time.RFC3339), manifest.ToolVersion)
+	if manifest.ServerUrl != SERVER_CONFIGS.ServerUrl || manifest.TenantDomain != SERVER_CONFIGS.TenantDomain ||
+		manifest.OrganizationId != SERVER_CONFIGS.OrganizationId {
+		if !force {
+			return fmt.Errorf("the backup was taken from %s (tenant: %s), which is not the target environment: %s "+
+				"(tenant: %s). Use the force flag to restore it anyway", manifest.ServerUrl, manifest.TenantDomain,
+				SERVER_CONFIGS.ServerUrl, SERVER_CONFIGS.TenantDomain)
+		}
+		log.Printf("Warning: The backup is restored to %s (tenant: %s), which is not the environment it was taken from.",
+			SERVER_CONFIGS.ServerUrl, SERVER_CONFIGS.TenantDomain)
+	}
+
+	ImportAllResources(tempDirPath)
+	return nil
+}
+
+// WriteBackupArchive writes the files of the source directory and the manifest with their checksums into a compressed
+// archive at the given path.
+func WriteBackupArchive(sourceDirPath string, archivePath string, manifest BackupManifest) error {
+
+	manifest.Files = make(map[string]string)
+	var filePaths []string
+	err := filepath.Walk(sourceDirPath, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		relativePath, err := filepath.Rel(sourceDirPath, filePath)
+		if err != nil {
+			return err
+		}
+		checksum, err := getFileChecksum(filePath)
+		if err != nil {
+			return err
+		}
+		manifest.Files[filepath.ToSlash(relativePath)] = checksum
+		filePaths = append(filePaths, relativePath)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error when reading the exported files: %s", err)
+	}
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error when creating the backup manifest: %s", err)
+	}
+
+	// The archive contains secrets, hence it is only readable by the owner.
+	archiveFile, err := os.OpenFile(archivePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("error when creating the backup archive: %s", err)
+	}
+	defer archiveFile.Close()
+	gzipWriter := gzip.NewWriter(archiveFile)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	if err := writeArchiveEntry(tarWriter, BACKUP_MANIFEST_FILE, manifestData, manifest.CreatedAt); err != nil {
+		return err
+	}
+	for _, relativePath := range filePaths {
+		fileData, err := ioutil.ReadFile(filepath.Join(sourceDirPath, relativePath))
+		if err != nil {
+			return fmt.Errorf("error when reading the exported file: %s", err)
+		}
+		if err := writeArchiveEntry(tarWriter, filepath.ToSlash(relativePath), fileData, manifest.CreatedAt); err != nil {
+			return err
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return fmt.Errorf("error when writing the backup archive: %s", err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return fmt.Errorf("error when writing the backup archive: %s", err)
+	}
+	return nil
+}
+
+func writeArchiveEntry(tarWriter *tar.Writer, name string, data []byte, modTime time.Time) error {
+
+	header := &tar.Header{Name: name, Mode: 0600, Size: int64(len(data)), ModTime: modTime}
+	if err := tarWriter.WriteHeader(header); err != nil {
+		return fmt.Errorf("error when writing the backup archive: %s", err)
+	}
+	if _, err := tarWriter.Write(data); err != nil {
+		return fmt.Errorf("error when writing the backup archive: %s", err)
+	}
+	return nil
+}
+
+// ExtractBackupArchive extracts the files of a backup archive into the given directory and verifies them against the
+// checksums in the manifest of the archive.
+func ExtractBackupArchive(archivePath string, destDirPath string) (BackupManifest, error) {
+
+	var manifest BackupManifest
+	archiveFile, err := os.Open(archivePath)
+	if err != nil {
+		return manifest, fmt.Errorf("error when opening the backup archive: %s", err)
+	}
+	defer archiveFile.Close()
+	gzipReader, err := gzip.NewReader(archiveFile)
+	if err != nil {
+		return manifest, fmt.Errorf("invalid backup archive: %s", err)
+	}
+	tarReader := tar.NewReader(gzipReader)
+
+	var extractedFiles []string
+	manifestFound := false
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return manifest, fmt.Errorf("invalid backup archive: %s", err)
+		}
+		name := path.Clean(header.Name)
+		if header.Typeflag != tar.TypeReg || path.IsAbs(name) || strings.HasPrefix(name, "../") {
+			return manifest, fmt.Errorf("invalid entry in the backup archive: %s", header.Name)
+		}
+		data, err := ioutil.ReadAll(tarReader)
+		if err != nil {
+			return manifest, fmt.Errorf("error when reading the backup archive: %s", err)
+		}
+		if name == BACKUP_MANIFEST_FILE {
+			if err := json.Unmarshal(data, &manifest); err != nil {
+				return manifest, fmt.Errorf("invalid backup manifest: %s", err)
+			}
+			manifestFound = true
+			continue
+		}
+		filePath := filepath.Join(destDirPath, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0700); err != nil {
+			return manifest, fmt.Errorf("error when extracting the backup archive: %s", err)
+		}
+		if err := ioutil.WriteFile(filePath, data, 0600); err != nil {
+			return manifest, fmt.Errorf("error when extracting the backup archive: %s", err)
+		}
+		extractedFiles = append(extractedFiles, name)
+	}
+	if !manifestFound {
+		return manifest, fmt.Errorf("manifest not found in the backup archive")
+	}
+
+	// Verify that the archive contains exactly the files in the manifest, without any modifications.
+	for _, name := range extractedFiles {
+		if _, ok := manifest.Files[name]; !ok {
+			return manifest, fmt.Errorf("file not listed in the backup manifest: %s", name)
+		}
+	}
+	for name, expectedChecksum := range manifest.Files {
+		checksum, err := getFileChecksum(filepath.Join(destDirPath, filepath.FromSlash(name)))
+		if err != nil {
+			return manifest, fmt.Errorf("file listed in the backup manifest not found: %s", name)
+		}
+		if checksum != expectedChecksum {
+			return manifest, fmt.Errorf("checksum mismatch for the file: %s", name)
+		}
+	}
+	return manifest, nil
+}
+
+// PruneBackups removes the backup archives of the tenant in the given directory that are not within the retention
+// limits, and returns the paths of the removed archives. The latest archives up to the given count are kept, and the
+// archives older than the given number of days are removed. A limit of zero or less is not applied.
+func PruneBackups(backupDirPath string, tenantDomain string, retainCount int, retainDays int) ([]string, error) {
+
+	files, err := ioutil.ReadDir(backupDirPath)
+	if err != nil {
+		return nil, fmt.Errorf("error when reading the backup directory: %s", err)
+	}
+
+	type backupArchive struct {
+		path      string
+		createdAt time.Time
+	}
+	var archives []backupArchive
+	prefix := BACKUP_FILE_PREFIX + tenantDomain + "-"
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, BACKUP_FILE_EXTENSION) {
+			continue
+		}
+		timestamp := strings.TrimSuffix(strings.TrimPrefix(name, prefix), BACKUP_FILE_EXTENSION)
+		createdAt, err := time.Parse(BACKUP_TIME_FORMAT, timestamp)
+		if err != nil {
+			continue
+		}
+		archives = append(archives, backupArchive{path: filepath.Join(backupDirPath, name), createdAt: createdAt})
+	}
+	sort.Slice(archives, func(i, j int) bool {
+		return archives[i].createdAt.After(archives[j].createdAt)
+	})
+
+	var removedArchives []string
+	for i, archive := range archives {
+		exceedsCount := retainCount > 0 && i >= retainCount
+		exceedsAge := retainDays > 0 && time.Since(archive.createdAt) > time.Duration(retainDays)*24*time.Hour
+		if !exceedsCount && !exceedsAge {
+			continue
+		}
+		if err := os.Remove(archive.path); err != nil {
+			return removedArchives, fmt.Errorf("error when removing the backup archive: %s", err)
+		}
+		removedArchives = append(removedArchives, archive.path)
+	}
+	return removedArchives, nil
+}
+
+func getBackupFileName(tenantDomain string, createdAt time.Time) string {
+
+	return BACKUP_FILE_PREFIX + tenantDomain + "-" + createdAt.Format(BACKUP_TIME_FORMAT) + BACKUP_FILE_EXTENSION
+}
+
+func getFileChecksum(filePath string) (string, error) {
+
+	fileData, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return "", err
+	}
+	checksum := sha256.Sum256(fileData)
+	return hex.EncodeToString(checksum[:]), nil
+}
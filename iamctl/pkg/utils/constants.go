@@ -23,12 +23,29 @@ const APPLICATIONS_CONFIG = "APPLICATIONS"
 const IDP_CONFIG = "IDENTITY_PROVIDERS"
 const CLAIM_CONFIG = "CLAIMS"
 const USERSTORES_CONFIG = "USERSTORES"
+const ROLES_CONFIG = "ROLES"
+const GROUPS_CONFIG = "GROUPS"
+const USERS_CONFIG = "USERS"
+const OIDC_SCOPES_CONFIG = "OIDC_SCOPES"
+const EMAIL_TEMPLATES_CONFIG = "EMAIL_TEMPLATES"
+const SMS_TEMPLATES_CONFIG = "SMS_TEMPLATES"
+const SERVER_CONFIGURATIONS_CONFIG = "SERVER_CONFIGURATIONS"
+const BRANDING_CONFIG = "BRANDING"
+const API_RESOURCES_CONFIG = "API_RESOURCES"
 
 // Tool configs
 const EXCLUDE_CONFIG = "EXCLUDE"
 const INCLUDE_ONLY_CONFIG = "INCLUDE_ONLY"
 const EXCLUDE_SECRETS_CONFIG = "EXCLUDE_SECRETS"
 const ALLOW_DELETE_CONFIG = "ALLOW_DELETE"
+const MAX_RETRY_ATTEMPTS_CONFIG = "MAX_RETRY_ATTEMPTS"
+const MAX_RETRY_BACKOFF_CONFIG = "MAX_RETRY_BACKOFF"
+const REQUEST_TIMEOUT_CONFIG = "REQUEST_TIMEOUT"
+const INCLUDE_MEMBERS_CONFIG = "INCLUDE_MEMBERS"
+const ENABLE_EXPORT_CONFIG = "ENABLE_EXPORT"
+const GENERATED_PASSWORDS_FILE_CONFIG = "GENERATED_PASSWORDS_FILE"
+const LOCALES_CONFIG = "LOCALES"
+const CUSTOM_TEXT_SCREENS_CONFIG = "CUSTOM_TEXT_SCREENS"
 
 // Keyword configs
 const KEYWORD_MAPPINGS_CONFIG = "KEYWORD_MAPPINGS"
@@ -41,12 +58,32 @@ const TENANT_DOMAIN_CONFIG = "TENANT_DOMAIN"
 const TOOL_CONFIG_PATH = "TOOL_CONFIG_PATH"
 const KEYWORD_CONFIG_PATH = "KEYWORD_CONFIG_PATH"
 const TOKEN_CONFIG = "TOKEN"
+const CA_CERT_PATH_CONFIG = "CA_CERT_PATH"
+const CLIENT_CERT_PATH_CONFIG = "CLIENT_CERT_PATH"
+const CLIENT_KEY_PATH_CONFIG = "CLIENT_KEY_PATH"
+const INSECURE_SKIP_VERIFY_CONFIG = "INSECURE_SKIP_VERIFY"
+const ORGANIZATION_ID_CONFIG = "ORGANIZATION_ID"
+const TENANT_DOMAINS_CONFIG = "TENANT_DOMAINS"
+const DISCOVER_TENANTS_CONFIG = "DISCOVER_TENANTS"
+const TENANTS_CONFIG = "TENANTS"
 
 // Resource types
 const APPLICATIONS = "Applications"
 const IDENTITY_PROVIDERS = "IdentityProviders"
 const CLAIMS = "Claims"
 const USERSTORES = "UserStores"
+const ROLES = "Roles"
+const GROUPS = "Groups"
+const USERS = "Users"
+const OIDC_SCOPES = "OidcScopes"
+const EMAIL_TEMPLATES = "EmailTemplates"
+const SMS_TEMPLATES = "SmsTemplates"
+const SERVER_CONFIGURATIONS = "ServerConfigurations"
+const BRANDING = "Branding"
+const API_RESOURCES = "ApiResources"
+const ORGANIZATIONS = "Organizations"
+const TENANTS = "Tenants"
+const BACKUPS = "Backups"
 
 // Config file names
 const SERVER_CONFIG_FILE = "serverConfig.json"
@@ -66,6 +103,9 @@ const CONSOLE = "Console"
 const MY_ACCOUNT = "My Account"
 const OAUTH2 = "oauth2"
 
+// Prefix of the names of the roles of the application audience, which are managed along with the applications.
+const APPLICATION_ROLE_PREFIX = "Application/"
+
 // Error codes
 var ErrorCodes = map[int]string{
 
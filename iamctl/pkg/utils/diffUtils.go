@@ -0,0 +1,383 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const FIELD_CHANGED = "changed"
+const FIELD_ONLY_LOCAL = "onlyLocal"
+const FIELD_ONLY_DEPLOYED = "onlyDeployed"
+const FILE_CHANGED = "fileChanged"
+
+type FieldDiff struct {
+	Path          string
+	Type          string
+	LocalValue    string
+	DeployedValue string
+}
+
+type DiffSummary struct {
+	InSync       int
+	Drifted      int
+	OnlyLocal    int
+	OnlyDeployed int
+	Failed       int
+}
+
+var DiffSummaryData DiffSummary
+
+func DiffAllResources(inputDirPath string, format string) {
+
+	for _, handler := range GetResourceHandlers() {
+		DiffResources(handler, inputDirPath, format)
+	}
+}
+
+func DiffResources(handler ResourceHandler, inputDirPath string, format string) {
+
+	// Compare deployed resources with the local files in the resource type folder.
+	resourceType := handler.GetResourceType()
+	log.Printf("Comparing %s...", resourceType)
+	localDirPath := filepath.Join(inputDirPath, resourceType)
+
+	if IsResourceTypeExcluded(resourceType) {
+		return
+	}
+	if !isExportEnabled(handler) {
+		log.Printf("Export is not enabled for %s. Skipping the comparison.", resourceType)
+		return
+	}
+	inventory, err := GetInventory(handler)
+	if err != nil {
+		log.Printf("Error: when comparing %s. %s", resourceType, err)
+		return
+	}
+	resources := inventory.List()
+
+	var deployedFileNames []string
+	resourceConfigs := GetResourceToolConfigs(handler)
+	excludeSecrets := AreSecretsExcluded(resourceConfigs)
+	for _, resource := range resources {
+		if IsDeployedResourceExcluded(handler, resource, resourceConfigs) {
+			continue
+		}
+		exportedFileName, exportedContent, attachments, err := GetExportedContent(handler, resource, localDirPath, format, excludeSecrets)
+		if err != nil {
+			DiffSummaryData.Failed++
+			log.Printf("Error while retrieving %s: %s. %s", resourceType, resource.Name, err)
+			continue
+		}
+		deployedFileNames = append(deployedFileNames, filepath.Base(exportedFileName))
+		for attachmentPath := range attachments {
+			deployedFileNames = append(deployedFileNames, filepath.Base(attachmentPath))
+		}
+		keywordMapping := GetResourceKeywordMapping(handler, GetFileInfo(exportedFileName).ResourceName)
+		DiffWithLocalFile(exportedFileName, exportedContent, attachments, keywordMapping, resourceType, resource.Name)
+	}
+	DiffLocalOnlyResources(handler, localDirPath, deployedFileNames, resourceConfigs)
+}
+
+func DiffWithLocalFile(localFilePath string, exportedContent []byte, attachments map[string][]byte,
+	keywordMapping map[string]interface{}, resourceType string, resourceName string) {
+
+	localFileData, err := ioutil.ReadFile(localFilePath)
+	if err != nil {
+		DiffSummaryData.OnlyDeployed++
+		printResourceDiffHeader(resourceType, resourceName)
+		fmt.Println("  Resource exists only in the target environment.")
+		return
+	}
+
+	// Compare the resolved values on both sides so that keyword placeholders do not show up as drift.
+	localContent := []byte(ReplaceKeywords(string(localFileData), keywordMapping))
+	deployedContent := []byte(ReplaceKeywords(string(exportedContent), keywordMapping))
+
+	diffs, err := CompareResourceContent(localContent, deployedContent, resourceType)
+	if err != nil {
+		DiffSummaryData.Failed++
+		log.Printf("Error while comparing %s: %s. %s", resourceType, resourceName, err)
+		return
+	}
+	diffs = append(diffs, compareAttachments(attachments, keywordMapping)...)
+	if len(diffs) == 0 {
+		DiffSummaryData.InSync++
+		return
+	}
+	DiffSummaryData.Drifted++
+	printResourceDiffHeader(resourceType, resourceName)
+	for _, diff := range diffs {
+		switch diff.Type {
+		case FIELD_CHANGED:
+			fmt.Printf("  ~ %s\n      local:    %s\n      deployed: %s\n", diff.Path, diff.LocalValue, diff.DeployedValue)
+		case FIELD_ONLY_LOCAL:
+			fmt.Printf("  - %s: %s\n", diff.Path, diff.LocalValue)
+		case FIELD_ONLY_DEPLOYED:
+			fmt.Printf("  + %s: %s\n", diff.Path, diff.DeployedValue)
+		case FILE_CHANGED:
+			fmt.Printf("  ~ %s: %s\n", diff.Path, diff.LocalValue)
+		}
+	}
+}
+
+func DiffLocalOnlyResources(handler ResourceHandler, localDirPath string, deployedFileNames []string,
+	resourceConfigs map[string]interface{}) {
+
+	resourceType := handler.GetResourceType()
+	files, err := ioutil.ReadDir(localDirPath)
+	if err != nil {
+		return
+	}
+	for _, file := range files {
+		if Contains(deployedFileNames, file.Name()) || IsAttachmentFile(handler, file.Name()) {
+			continue
+		}
+		resourceName := GetFileInfo(file.Name()).ResourceName
+		filePath := filepath.Join(localDirPath, file.Name())
+		fileData, _ := readLocalFile(handler, filePath)
+		localResource := LocalResource{Resource: Resource{Name: resourceName}, FilePath: filePath, FileData: fileData}
+		if IsLocalResourceExcluded(handler, localResource, resourceConfigs) {
+			continue
+		}
+		DiffSummaryData.OnlyLocal++
+		printResourceDiffHeader(resourceType, resourceName)
+		fmt.Printf("  Resource exists only in the local directory: %s\n", filePath)
+	}
+}
+
+func CompareResourceContent(localContent []byte, deployedContent []byte, resourceType string) ([]FieldDiff, error) {
+
+	var localYaml, deployedYaml interface{}
+	if err := yaml.Unmarshal(ReplaceTypeTags(localContent), &localYaml); err != nil {
+		return nil, fmt.Errorf("error when parsing the local file. %w", err)
+	}
+	if err := yaml.Unmarshal(ReplaceTypeTags(deployedContent), &deployedYaml); err != nil {
+		return nil, fmt.Errorf("error when parsing the exported content. %w", err)
+	}
+
+	var diffs []FieldDiff
+	compareValues([]string{}, localYaml, deployedYaml, GetArrayIdentifiers(resourceType), &diffs)
+	return diffs, nil
+}
+
+func PrintDiffSummary() {
+
+	fmt.Println("========================================")
+	fmt.Println("Diff Summary:")
+	fmt.Println("========================================")
+	fmt.Printf("In sync: %d\n", DiffSummaryData.InSync)
+	fmt.Printf("Drifted: %d\n", DiffSummaryData.Drifted)
+	fmt.Printf("Only in local directory: %d\n", DiffSummaryData.OnlyLocal)
+	fmt.Printf("Only in target environment: %d\n", DiffSummaryData.OnlyDeployed)
+	fmt.Printf("Failed to compare: %d\n", DiffSummaryData.Failed)
+	fmt.Println("----------------------------------------")
+}
+
+// compareAttachments compares the exported attachments of a resource with the local attachment files.
+func compareAttachments(attachments map[string][]byte, keywordMapping map[string]interface{}) []FieldDiff {
+
+	var attachmentPaths []string
+	for attachmentPath := range attachments {
+		attachmentPaths = append(attachmentPaths, attachmentPath)
+	}
+	sort.Strings(attachmentPaths)
+
+	var diffs []FieldDiff
+	for _, attachmentPath := range attachmentPaths {
+		localAttachment, err := ioutil.ReadFile(attachmentPath)
+		if err != nil {
+			diffs = append(diffs, FieldDiff{Path: filepath.Base(attachmentPath), Type: FILE_CHANGED,
+				LocalValue: "file not found in the local directory"})
+		} else if !isAttachmentInSync(localAttachment, attachments[attachmentPath], keywordMapping) {
+			diffs = append(diffs, FieldDiff{Path: filepath.Base(attachmentPath), Type: FILE_CHANGED,
+				LocalValue: "file content differs from the deployed content"})
+		}
+	}
+	return diffs
+}
+
+func printResourceDiffHeader(resourceType string, resourceName string) {
+
+	fmt.Println("----------------------------------------")
+	fmt.Printf("%s: %s\n", resourceType, resourceName)
+	fmt.Println("----------------------------------------")
+}
+
+func compareValues(path []string, local interface{}, deployed interface{}, identifiers map[string]string, diffs *[]FieldDiff) {
+
+	localMap, isLocalMap := toStringKeyedMap(local)
+	deployedMap, isDeployedMap := toStringKeyedMap(deployed)
+	if isLocalMap && isDeployedMap {
+		compareMaps(path, localMap, deployedMap, identifiers, diffs)
+		return
+	}
+
+	localArray, isLocalArray := local.([]interface{})
+	deployedArray, isDeployedArray := deployed.([]interface{})
+	if isLocalArray && isDeployedArray {
+		compareArrays(path, localArray, deployedArray, identifiers, diffs)
+		return
+	}
+
+	thisPath := strings.Join(path, ".")
+	switch {
+	case local == nil && deployed == nil:
+	case deployed == nil:
+		*diffs = append(*diffs, FieldDiff{Path: thisPath, Type: FIELD_ONLY_LOCAL, LocalValue: formatDiffValue(local)})
+	case local == nil:
+		*diffs = append(*diffs, FieldDiff{Path: thisPath, Type: FIELD_ONLY_DEPLOYED, DeployedValue: formatDiffValue(deployed)})
+	case formatDiffValue(local) != formatDiffValue(deployed):
+		*diffs = append(*diffs, FieldDiff{Path: thisPath, Type: FIELD_CHANGED,
+			LocalValue: formatDiffValue(local), DeployedValue: formatDiffValue(deployed)})
+	}
+}
+
+func compareMaps(path []string, local map[string]interface{}, deployed map[string]interface{},
+	identifiers map[string]string, diffs *[]FieldDiff) {
+
+	keys := make([]string, 0, len(local)+len(deployed))
+	for key := range local {
+		keys = append(keys, key)
+	}
+	for key := range deployed {
+		if _, ok := local[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		newPath := append(append([]string{}, path...), key)
+		compareValues(newPath, local[key], deployed[key], identifiers, diffs)
+	}
+}
+
+func compareArrays(path []string, local []interface{}, deployed []interface{}, identifiers map[string]string, diffs *[]FieldDiff) {
+
+	// Match array elements by the identifiers defined for the resource type instead of their position.
+	localElements, localKeys, ok := indexArrayElements(path, local, identifiers)
+	deployedElements, deployedKeys, deployedOk := indexArrayElements(path, deployed, identifiers)
+	if !ok || !deployedOk {
+		compareScalarArrays(path, local, deployed, diffs)
+		return
+	}
+
+	for _, key := range localKeys {
+		newPath := append(append([]string{}, path...), key)
+		compareValues(newPath, localElements[key], deployedElements[key], identifiers, diffs)
+	}
+	for _, key := range deployedKeys {
+		if _, exists := localElements[key]; !exists {
+			newPath := append(append([]string{}, path...), key)
+			compareValues(newPath, nil, deployedElements[key], identifiers, diffs)
+		}
+	}
+}
+
+func indexArrayElements(path []string, array []interface{}, identifiers map[string]string) (map[string]interface{}, []string, bool) {
+
+	elements := make(map[string]interface{})
+	var keys []string
+	if len(path) == 0 {
+		return nil, nil, false
+	}
+	for _, element := range array {
+		if _, isMap := toStringKeyedMap(element); !isMap {
+			return nil, nil, false
+		}
+		key, err := resolvePathWithIdentifiers(path[len(path)-1], element, identifiers)
+		if err != nil {
+			return nil, nil, false
+		}
+		if _, exists := elements[key]; exists {
+			return nil, nil, false
+		}
+		elements[key] = element
+		keys = append(keys, key)
+	}
+	return elements, keys, true
+}
+
+func compareScalarArrays(path []string, local []interface{}, deployed []interface{}, diffs *[]FieldDiff) {
+
+	// Compare arrays without identifiers as unordered collections to avoid reporting reordering as drift.
+	thisPath := strings.Join(path, ".")
+	remaining := make(map[string]int)
+	for _, value := range deployed {
+		remaining[formatDiffValue(value)]++
+	}
+	for _, value := range local {
+		formattedValue := formatDiffValue(value)
+		if remaining[formattedValue] > 0 {
+			remaining[formattedValue]--
+			continue
+		}
+		*diffs = append(*diffs, FieldDiff{Path: thisPath, Type: FIELD_ONLY_LOCAL, LocalValue: formattedValue})
+	}
+	for _, value := range deployed {
+		formattedValue := formatDiffValue(value)
+		if remaining[formattedValue] > 0 {
+			remaining[formattedValue]--
+			*diffs = append(*diffs, FieldDiff{Path: thisPath, Type: FIELD_ONLY_DEPLOYED, DeployedValue: formattedValue})
+		}
+	}
+}
+
+func toStringKeyedMap(value interface{}) (map[string]interface{}, bool) {
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return v, true
+	case map[interface{}]interface{}:
+		stringKeyedMap := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			stringKeyedMap[fmt.Sprintf("%v", key)] = val
+		}
+		return stringKeyedMap, true
+	}
+	return nil, false
+}
+
+func formatDiffValue(value interface{}) string {
+
+	if _, isMap := toStringKeyedMap(value); isMap {
+		return compactYaml(value)
+	}
+	if _, isArray := value.([]interface{}); isArray {
+		return compactYaml(value)
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+func compactYaml(value interface{}) string {
+
+	content, err := yaml.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return strings.Join(strings.Fields(strings.ReplaceAll(string(content), "\n", " ")), " ")
+}
@@ -0,0 +1,104 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"log"
+	"os"
+	"sync"
+)
+
+// Exit codes of the exportAll and importAll commands.
+const EXIT_CODE_SUCCESS = 0
+const EXIT_CODE_PARTIAL_FAILURE = 1
+const EXIT_CODE_TOTAL_FAILURE = 2
+const EXIT_CODE_CONFIG_ERROR = 3
+const EXIT_CODE_AUTH_ERROR = 4
+
+var (
+	// Stop processing resources after the first failure.
+	FAIL_FAST  bool
+	runAborted bool
+	abortMutex sync.Mutex
+)
+
+// ExitWithError logs the given error and exits the tool with the given exit code.
+func ExitWithError(exitCode int, v ...interface{}) {
+
+	log.Println(v...)
+	os.Exit(exitCode)
+}
+
+// GetExitCode returns the exit code of the run according to the failed and successful operations in the summary.
+func GetExitCode() int {
+
+	summaryMutex.Lock()
+	defer summaryMutex.Unlock()
+
+	if SummaryData.FailedOperations == 0 {
+		return EXIT_CODE_SUCCESS
+	}
+	if SummaryData.SuccessfulOperations == 0 {
+		return EXIT_CODE_TOTAL_FAILURE
+	}
+	return EXIT_CODE_PARTIAL_FAILURE
+}
+
+func getFailedOperationCount() int {
+
+	summaryMutex.Lock()
+	defer summaryMutex.Unlock()
+
+	return SummaryData.FailedOperations
+}
+
+// IsRunAborted returns true if the run should not process any more resources, due to a failure in fail fast mode.
+func IsRunAborted() bool {
+
+	abortMutex.Lock()
+	defer abortMutex.Unlock()
+
+	return runAborted
+}
+
+func abortRunIfFailFast() {
+
+	if !FAIL_FAST {
+		return
+	}
+	abortMutex.Lock()
+	defer abortMutex.Unlock()
+
+	if !runAborted {
+		log.Println("Stopping the run after the first failure since fail fast mode is enabled.")
+		runAborted = true
+	}
+}
+
+func ResetRunState() {
+
+	abortMutex.Lock()
+	runAborted = false
+	abortMutex.Unlock()
+
+	summaryMutex.Lock()
+	SummaryData = Summary{}
+	ResourceSummaries = nil
+	summaryMutex.Unlock()
+}
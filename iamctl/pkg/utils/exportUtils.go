@@ -0,0 +1,206 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+func ExportAllResources(outputDirPath string, format string) {
+
+	for _, handler := range GetResourceHandlers() {
+		if IsRunAborted() {
+			return
+		}
+		ExportResources(handler, outputDirPath, format)
+	}
+}
+
+func ExportResources(handler ResourceHandler, outputDirPath string, format string) {
+
+	// Export all resources of the given type to the resource type folder.
+	resourceType := handler.GetResourceType()
+	log.Printf("Exporting %s...", resourceType)
+	exportDirPath := filepath.Join(outputDirPath, resourceType)
+
+	if IsResourceTypeExcluded(resourceType) {
+		return
+	}
+	if !isExportEnabled(handler) {
+		log.Printf("Export is not enabled for %s.", resourceType)
+		return
+	}
+	inventory, err := GetInventory(handler)
+	if err != nil {
+		UpdateFailureSummary(resourceType, resourceType)
+		log.Printf("Error: when exporting %s. %s", resourceType, err)
+		return
+	}
+	resources := inventory.List()
+
+	if _, err := os.Stat(exportDirPath); os.IsNotExist(err) {
+		os.MkdirAll(exportDirPath, 0700)
+	} else {
+		if TOOL_CONFIGS.AllowDelete {
+			RemoveDeletedLocalResources(handler, exportDirPath, getDeployedResourceNames(handler, resources))
+		}
+	}
+
+	resourceConfigs := GetResourceToolConfigs(handler)
+	excludeSecrets := AreSecretsExcluded(resourceConfigs) && !INCLUDE_SECRETS
+	RunInParallel(len(resources), func(i int) {
+		resource := resources[i]
+		if IsDeployedResourceExcluded(handler, resource, resourceConfigs) {
+			AddSkippedResourceResult(resourceType, resource.Name, EXCLUDE, "Resource is excluded in the tool configs.")
+			return
+		}
+		exportResourceWithResult(handler, resource, exportDirPath, format, excludeSecrets)
+	})
+}
+
+// ExportNamedResources exports the resources of the given type with the given names to the resource type folder,
+// regardless of the resources excluded in the tool configs.
+func ExportNamedResources(handler ResourceHandler, outputDirPath string, format string, resourceNames []string) {
+
+	resourceType := handler.GetResourceType()
+	exportDirPath := filepath.Join(outputDirPath, resourceType)
+
+	inventory, err := GetInventory(handler)
+	if err != nil {
+		UpdateFailureSummary(resourceType, resourceType)
+		log.Printf("Error: when exporting %s. %s", resourceType, err)
+		return
+	}
+	if err := os.MkdirAll(exportDirPath, 0700); err != nil {
+		UpdateFailureSummary(resourceType, resourceType)
+		log.Printf("Error: when creating the output directory for %s. %s", resourceType, err)
+		return
+	}
+
+	excludeSecrets := AreSecretsExcluded(GetResourceToolConfigs(handler)) && !INCLUDE_SECRETS
+	RunInParallel(len(resourceNames), func(i int) {
+		resource, ok := inventory.GetByName(resourceNames[i])
+		if !ok {
+			err := fmt.Errorf("resource not found in the target environment")
+			AddResourceResult(resourceType, resourceNames[i], EXPORT, err, time.Now())
+			UpdateFailureSummary(resourceType, resourceNames[i])
+			log.Printf("Error while exporting %s: %s. %s", resourceType, resourceNames[i], err)
+			return
+		}
+		exportResourceWithResult(handler, resource, exportDirPath, format, excludeSecrets)
+	})
+}
+
+// exportResourceWithResult exports the given resource and records the result in the summary and the report.
+func exportResourceWithResult(handler ResourceHandler, resource Resource, exportDirPath string, format string,
+	excludeSecrets bool) {
+
+	resourceType := handler.GetResourceType()
+	log.Printf("Exporting %s: %s", resourceType, resource.Name)
+	startTime := time.Now()
+	err := exportResource(handler, resource, exportDirPath, format, excludeSecrets)
+	AddResourceResult(resourceType, resource.Name, EXPORT, err, startTime)
+	if err != nil {
+		UpdateFailureSummary(resourceType, resource.Name)
+		log.Printf("Error while exporting %s: %s. %s", resourceType, resource.Name, err)
+	} else {
+		UpdateSuccessSummary(resourceType, EXPORT)
+		log.Printf("%s exported successfully: %s", resourceType, resource.Name)
+	}
+}
+
+func exportResource(handler ResourceHandler, resource Resource, exportDirPath string, format string, excludeSecrets bool) error {
+
+	exportedFileName, modifiedFile, attachments, err := GetExportedContent(handler, resource, exportDirPath, format, excludeSecrets)
+	if err != nil {
+		return err
+	}
+
+	err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644)
+	if err != nil {
+		return fmt.Errorf("error when writing the exported content to file: %w", err)
+	}
+	for attachmentPath, attachment := range attachments {
+		if err := ioutil.WriteFile(attachmentPath, attachment, 0644); err != nil {
+			return fmt.Errorf("error when writing the exported content to file: %w", err)
+		}
+	}
+	return nil
+}
+
+// GetExportedContent exports the given resource and adds the keyword placeholders used in the local file at the output directory.
+// The attachments of the resource are returned by their file paths in the output directory.
+func GetExportedContent(handler ResourceHandler, resource Resource, outputDirPath string, format string,
+	excludeSecrets bool) (string, []byte, map[string][]byte, error) {
+
+	fileName, body, err := handler.ExportResource(resource, format, excludeSecrets)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	exportedFileName := filepath.Join(outputDirPath, fileName)
+	fileInfo := GetFileInfo(exportedFileName)
+	keywordMapping := GetResourceKeywordMapping(handler, fileInfo.ResourceName)
+
+	var attachments map[string][]byte
+	if attachmentHandler, ok := handler.(AttachmentHandler); ok {
+		body, attachments, err = getExportedAttachments(attachmentHandler, resource, fileName, body, outputDirPath, keywordMapping)
+		if err != nil {
+			return "", nil, nil, err
+		}
+	}
+
+	modifiedFile, err := ProcessExportedContent(exportedFileName, body, keywordMapping, handler.GetResourceType())
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("error while processing the exported content: %s", err)
+	}
+	return exportedFileName, modifiedFile, attachments, nil
+}
+
+// GetFileType returns the media type of the given export format.
+func GetFileType(format string) string {
+
+	// TODO: Extend support for json and xml formats.
+	switch format {
+	case "json":
+		return MEDIA_TYPE_JSON
+	case "xml":
+		return MEDIA_TYPE_XML
+	default:
+		return MEDIA_TYPE_YAML
+	}
+}
+
+// GetExportedFileName returns the file name given in the Content-Disposition header of an export response.
+func GetExportedFileName(resp *http.Response) (string, error) {
+
+	var attachmentDetail = resp.Header.Get("Content-Disposition")
+	_, params, err := mime.ParseMediaType(attachmentDetail)
+	if err != nil {
+		return "", fmt.Errorf("error while parsing the content disposition header: %s", err)
+	}
+	return params["filename"], nil
+}
@@ -0,0 +1,241 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"log"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const REGEX_PATTERN_PREFIX = "regex:"
+const ATTRIBUTE_PATTERN_PREFIX = "attr:"
+
+type compiledRegex struct {
+	regex *regexp.Regexp
+	err   error
+}
+
+// Regular expressions of the regex patterns, compiled once for each pattern.
+var (
+	compiledRegexes     = make(map[string]compiledRegex)
+	compiledRegexesLock sync.Mutex
+)
+
+// IsDeployedResourceExcluded returns true if the deployed resource is excluded by the INCLUDE_ONLY or EXCLUDE configs,
+// matching the attributes of the deployed resource for attribute patterns.
+func IsDeployedResourceExcluded(handler ResourceHandler, resource Resource, resourceConfigs map[string]interface{}) bool {
+
+	provider, ok := handler.(AttributeProvider)
+	if !ok {
+		return IsResourceExcluded(resource.Name, resourceConfigs)
+	}
+	return isResourceExcluded(resource.Name, getAttributesOnce(func() (map[string][]string, error) {
+		return provider.GetDeployedAttributes(resource)
+	}), resourceConfigs)
+}
+
+// IsLocalResourceExcluded returns true if the local resource is excluded by the INCLUDE_ONLY or EXCLUDE configs,
+// matching the attributes in the local file for attribute patterns.
+func IsLocalResourceExcluded(handler ResourceHandler, localResource LocalResource, resourceConfigs map[string]interface{}) bool {
+
+	provider, ok := handler.(AttributeProvider)
+	if !ok {
+		return IsResourceExcluded(localResource.Resource.Name, resourceConfigs)
+	}
+	return isResourceExcluded(localResource.Resource.Name, getAttributesOnce(func() (map[string][]string, error) {
+		return provider.GetLocalAttributes([]byte(localResource.FileData))
+	}), resourceConfigs)
+}
+
+// getAttributesOnce returns a function that retrieves the attributes of a resource when it is first called. The
+// attributes are considered empty if they cannot be retrieved.
+func getAttributesOnce(retrieve func() (map[string][]string, error)) func() map[string][]string {
+
+	var attributes map[string][]string
+	retrieved := false
+	return func() map[string][]string {
+		if !retrieved {
+			retrieved = true
+			var err error
+			if attributes, err = retrieve(); err != nil {
+				log.Printf("Error when retrieving the attributes of the resource for filtering. %s", err)
+			}
+		}
+		return attributes
+	}
+}
+
+// MatchesResourcePattern returns true if the resource matches the given pattern of the INCLUDE_ONLY or EXCLUDE configs.
+// A pattern can be one of the following:
+//   - The exact name of the resource.
+//   - A glob pattern matched against the name (ex: team-payments-*).
+//   - A regular expression with the "regex:" prefix, matched against the whole name (ex: regex:team-(payments|orders)-.+).
+//   - An attribute name and a value pattern with the "attr:" prefix (ex: attr:inboundProtocol=oauth2). The value pattern
+//     can be any of the above, and matches if any value of the attribute matches it.
+//
+// Attribute patterns never match if getAttributes is nil.
+func MatchesResourcePattern(pattern string, resourceName string, getAttributes func() map[string][]string) bool {
+
+	if strings.HasPrefix(pattern, ATTRIBUTE_PATTERN_PREFIX) {
+		if getAttributes == nil {
+			return false
+		}
+		attributePattern := strings.SplitN(strings.TrimPrefix(pattern, ATTRIBUTE_PATTERN_PREFIX), "=", 2)
+		if len(attributePattern) != 2 {
+			log.Printf("Invalid attribute pattern: %s. Expected the format attr:<name>=<value>.", pattern)
+			return false
+		}
+		for _, value := range getAttributes()[attributePattern[0]] {
+			if matchesNamePattern(attributePattern[1], value) {
+				return true
+			}
+		}
+		return false
+	}
+	return matchesNamePattern(pattern, resourceName)
+}
+
+func matchesNamePattern(pattern string, name string) bool {
+
+	if pattern == name {
+		return true
+	}
+	if strings.HasPrefix(pattern, REGEX_PATTERN_PREFIX) {
+		regex, err := compileRegexPattern(pattern)
+		if err != nil {
+			log.Printf("Invalid regular expression: %s. %s", pattern, err)
+			return false
+		}
+		return regex.MatchString(name)
+	}
+	if strings.ContainsAny(pattern, "*?[") {
+		matched, err := path.Match(pattern, name)
+		if err != nil {
+			log.Printf("Invalid glob pattern: %s. %s", pattern, err)
+			return false
+		}
+		return matched
+	}
+	return false
+}
+
+// compileRegexPattern returns the regular expression of the given regex pattern, anchored to match the whole name.
+func compileRegexPattern(pattern string) (*regexp.Regexp, error) {
+
+	compiledRegexesLock.Lock()
+	defer compiledRegexesLock.Unlock()
+
+	compiled, ok := compiledRegexes[pattern]
+	if !ok {
+		expression := "^(?:" + strings.TrimPrefix(pattern, REGEX_PATTERN_PREFIX) + ")$"
+		compiled.regex, compiled.err = regexp.Compile(expression)
+		compiledRegexes[pattern] = compiled
+	}
+	return compiled.regex, compiled.err
+}
+
+// validateResourcePattern returns an error if the given pattern of a resource is not in a valid format.
+func validateResourcePattern(pattern string) error {
+
+	if strings.HasPrefix(pattern, ATTRIBUTE_PATTERN_PREFIX) {
+		attributePattern := strings.SplitN(strings.TrimPrefix(pattern, ATTRIBUTE_PATTERN_PREFIX), "=", 2)
+		if len(attributePattern) != 2 || attributePattern[0] == "" {
+			return fmt.Errorf("invalid attribute pattern: %s. Expected the format attr:<name>=<value>", pattern)
+		}
+		pattern = attributePattern[1]
+	}
+	if strings.HasPrefix(pattern, REGEX_PATTERN_PREFIX) {
+		if _, err := compileRegexPattern(pattern); err != nil {
+			return fmt.Errorf("invalid regular expression: %s. %s", pattern, err)
+		}
+		return nil
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return fmt.Errorf("invalid glob pattern: %s. %s", pattern, err)
+	}
+	return nil
+}
+
+// AddResourceFilters adds the given patterns to the INCLUDE_ONLY and EXCLUDE configs. A pattern in the format
+// <resource type>:<pattern> is added to the configs of the resource type, and any other pattern is added to the
+// configs of the resource types. Attribute and regex patterns are accepted only for a resource type, and an error is
+// returned for a pattern that is not in a valid format.
+func AddResourceFilters(includePatterns []string, excludePatterns []string) error {
+
+	for _, pattern := range includePatterns {
+		if err := addResourceFilter(INCLUDE_ONLY_CONFIG, pattern); err != nil {
+			return err
+		}
+	}
+	for _, pattern := range excludePatterns {
+		if err := addResourceFilter(EXCLUDE_CONFIG, pattern); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addResourceFilter(configName string, pattern string) error {
+
+	if pattern == "" {
+		return fmt.Errorf("empty pattern given for the %s config", configName)
+	}
+	if strings.HasPrefix(pattern, ATTRIBUTE_PATTERN_PREFIX) || strings.HasPrefix(pattern, REGEX_PATTERN_PREFIX) {
+		return fmt.Errorf("resource type is not given for the pattern: %s in the %s config. "+
+			"Expected the format <resource type>:%s", pattern, configName, pattern)
+	}
+	if parts := strings.SplitN(pattern, ":", 2); len(parts) == 2 {
+		resourceType, resourcePattern := parts[0], parts[1]
+		handler, err := FindResourceHandler(resourceType)
+		if err != nil {
+			return fmt.Errorf("invalid pattern: %s in the %s config. %s", pattern, configName, err)
+		}
+		if resourcePattern == "" {
+			return fmt.Errorf("empty resource pattern given for %s in the %s config", resourceType, configName)
+		}
+		if err := validateResourcePattern(resourcePattern); err != nil {
+			return fmt.Errorf("%s given for %s in the %s config", err, resourceType, configName)
+		}
+		if TOOL_CONFIGS.ResourceConfigs == nil {
+			TOOL_CONFIGS.ResourceConfigs = make(map[string]map[string]interface{})
+		}
+		resourceConfigs := TOOL_CONFIGS.ResourceConfigs[handler.GetConfigKey()]
+		if resourceConfigs == nil {
+			resourceConfigs = make(map[string]interface{})
+			TOOL_CONFIGS.ResourceConfigs[handler.GetConfigKey()] = resourceConfigs
+		}
+		patterns, _ := resourceConfigs[configName].([]interface{})
+		resourceConfigs[configName] = append(patterns, resourcePattern)
+		return nil
+	}
+
+	if err := validateResourcePattern(pattern); err != nil {
+		return fmt.Errorf("%s given in the %s config", err, configName)
+	}
+	if configName == INCLUDE_ONLY_CONFIG {
+		TOOL_CONFIGS.IncludeOnly = append(TOOL_CONFIGS.IncludeOnly, pattern)
+	} else {
+		TOOL_CONFIGS.Exclude = append(TOOL_CONFIGS.Exclude, pattern)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+var httpClient *http.Client
+
+// GetHttpClient returns the client shared by all API requests of the tool.
+func GetHttpClient() (*http.Client, error) {
+
+	if httpClient == nil {
+		// Server configs are not loaded in the interactive mode, hence read the TLS configs from environment variables.
+		loadTLSConfigsFromEnvVar()
+		if err := InitHttpClient(SERVER_CONFIGS); err != nil {
+			return nil, fmt.Errorf("error when configuring the HTTP client: %s", err)
+		}
+	}
+	return httpClient, nil
+}
+
+func InitHttpClient(config ServerConfigs) error {
+
+	tlsConfig, err := BuildTLSConfig(config)
+	if err != nil {
+		return err
+	}
+
+	httpClient = &http.Client{
+		Timeout: DEFAULT_REQUEST_TIMEOUT * time.Second,
+		Transport: &http.Transport{
+			Proxy:               http.ProxyFromEnvironment,
+			TLSClientConfig:     tlsConfig,
+			MaxIdleConns:        100,
+			MaxIdleConnsPerHost: 10,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+	return nil
+}
+
+// BuildTLSConfig returns the TLS configuration of the client for the CA bundle, client certificate and certificate
+// verification settings of the given server configs.
+func BuildTLSConfig(config ServerConfigs) (*tls.Config, error) {
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if config.InsecureSkipVerify {
+		log.Println("Warning: TLS certificate verification is disabled. Do not use this option against production servers.")
+		tlsConfig.InsecureSkipVerify = true
+	}
+
+	// Trust the given CA bundle in addition to the system certificate pool.
+	if config.CaCertPath != "" {
+		caCerts, err := ioutil.ReadFile(config.CaCertPath)
+		if err != nil {
+			return nil, fmt.Errorf("error when reading the CA certificate bundle: %s", err)
+		}
+		certPool, err := x509.SystemCertPool()
+		if err != nil || certPool == nil {
+			certPool = x509.NewCertPool()
+		}
+		if !certPool.AppendCertsFromPEM(caCerts) {
+			return nil, fmt.Errorf("no valid certificates found in the CA certificate bundle: %s", config.CaCertPath)
+		}
+		tlsConfig.RootCAs = certPool
+	}
+
+	// Load the client certificate for mutual TLS.
+	if config.ClientCertPath != "" || config.ClientKeyPath != "" {
+		if config.ClientCertPath == "" || config.ClientKeyPath == "" {
+			return nil, fmt.Errorf("both %s and %s should be provided for mutual TLS", CLIENT_CERT_PATH_CONFIG, CLIENT_KEY_PATH_CONFIG)
+		}
+		clientCert, err := tls.LoadX509KeyPair(config.ClientCertPath, config.ClientKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("error when loading the client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+	return tlsConfig, nil
+}
+
+// loadTLSConfigsFromEnvVar reads the TLS configs of the interactive mode. Certificate verification is skipped unless
+// INSECURE_SKIP_VERIFY is set to false, since the interactive mode is mostly used against local servers with
+// self-signed certificates.
+func loadTLSConfigsFromEnvVar() {
+
+	SERVER_CONFIGS.InsecureSkipVerify = true
+
+	if caCertPath := os.Getenv(CA_CERT_PATH_CONFIG); caCertPath != "" {
+		SERVER_CONFIGS.CaCertPath = caCertPath
+	}
+	if clientCertPath := os.Getenv(CLIENT_CERT_PATH_CONFIG); clientCertPath != "" {
+		SERVER_CONFIGS.ClientCertPath = clientCertPath
+	}
+	if clientKeyPath := os.Getenv(CLIENT_KEY_PATH_CONFIG); clientKeyPath != "" {
+		SERVER_CONFIGS.ClientKeyPath = clientKeyPath
+	}
+	if insecureSkipVerify, err := strconv.ParseBool(os.Getenv(INSECURE_SKIP_VERIFY_CONFIG)); err == nil {
+		SERVER_CONFIGS.InsecureSkipVerify = insecureSkipVerify
+	}
+}
@@ -0,0 +1,366 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+func ImportAllResources(inputDirPath string) {
+
+	ImportResourceTypes(GetResourceHandlers(), inputDirPath)
+}
+
+// ImportResourceTypes imports the local files of the given handlers from the input directory in the given order. The
+// import is skipped if any of the local files refer to resources that do not exist, and the changes are reverted if
+// any of the operations fail when the rollback on failure is enabled.
+func ImportResourceTypes(handlers []ResourceHandler, inputDirPath string) {
+
+	// All the deployed resources are needed only to delete the resources that do not exist locally, or to add them to
+	// the snapshot.
+	localResourcesOnly = !TOOL_CONFIGS.AllowDelete && !(ROLLBACK_ON_FAILURE && !DRY_RUN)
+	if !validateReferences(handlers, inputDirPath) {
+		log.Println("Skipping the import since some of the local files refer to resources that do not exist.")
+		return
+	}
+	if ROLLBACK_ON_FAILURE && !DRY_RUN {
+		snapshot, err := createSnapshot(handlers, inputDirPath)
+		if err != nil {
+			UpdateFailureSummary(SNAPSHOT, SNAPSHOT)
+			log.Printf("Skipping the import since the snapshot of the deployed resources cannot be created. %s", err)
+			return
+		}
+		activeSnapshot = snapshot
+		failedOperations := getFailedOperationCount()
+		defer func() {
+			activeSnapshot = nil
+			if getFailedOperationCount() > failedOperations {
+				snapshot.rollback()
+			}
+		}()
+	}
+	for _, handler := range handlers {
+		if IsRunAborted() {
+			return
+		}
+		ImportResources(handler, inputDirPath)
+	}
+}
+
+func ImportResources(handler ResourceHandler, inputDirPath string) {
+
+	resourceType := handler.GetResourceType()
+	log.Printf("Importing %s...", resourceType)
+	importDirPath := filepath.Join(inputDirPath, resourceType)
+
+	if IsResourceTypeExcluded(resourceType) {
+		return
+	}
+	var files []os.FileInfo
+	if _, err := os.Stat(importDirPath); os.IsNotExist(err) {
+		log.Printf("No %s to import.", resourceType)
+	} else {
+		files, err = ioutil.ReadDir(importDirPath)
+		if err != nil {
+			UpdateFailureSummary(resourceType, resourceType)
+			log.Printf("Error importing %s: %s", resourceType, err)
+			return
+		}
+	}
+
+	inventory, err := GetInventory(handler)
+	if err != nil {
+		UpdateFailureSummary(resourceType, resourceType)
+		log.Printf("Error retrieving deployed %s: %s", resourceType, err)
+		return
+	}
+
+	var filePaths []string
+	for _, file := range files {
+		if !file.IsDir() && !IsAttachmentFile(handler, file.Name()) {
+			filePaths = append(filePaths, filepath.Join(importDirPath, file.Name()))
+		}
+	}
+	localResources, allFilesResolved := resolveLocalFiles(handler, filePaths, inventory)
+
+	if TOOL_CONFIGS.AllowDelete && !IsRunAborted() {
+		if allFilesResolved {
+			removeDeletedDeployedResources(handler, inventory, localResources)
+		} else {
+			log.Printf("Skipping the deletion of %s since some of the local files are invalid.", resourceType)
+		}
+	}
+
+	resourceConfigs := GetResourceToolConfigs(handler)
+	for _, batch := range getImportBatches(handler, localResources) {
+		RunInParallel(len(batch), func(i int) {
+			localResource := batch[i]
+			if IsLocalResourceExcluded(handler, localResource, resourceConfigs) {
+				AddSkippedResourceResult(resourceType, localResource.Resource.Name, EXCLUDE, "Resource is excluded in the tool configs.")
+				return
+			}
+			err := ImportLocalResource(handler, inventory, localResource)
+			if err != nil {
+				log.Printf("Error importing %s: %s", resourceType, err)
+			}
+		})
+	}
+}
+
+// ImportLocalFiles imports the resources defined in the given local files of the resource type, regardless of the
+// resources excluded in the tool configs. Deployed resources that are not in the given files are not deleted, and the
+// files are not imported if they refer to resources that are neither in the given files nor deployed.
+func ImportLocalFiles(handler ResourceHandler, filePaths []string) {
+
+	localResourcesOnly = true
+	resourceType := handler.GetResourceType()
+	inventory, err := GetInventory(handler)
+	if err != nil {
+		UpdateFailureSummary(resourceType, resourceType)
+		log.Printf("Error retrieving deployed %s: %s", resourceType, err)
+		return
+	}
+
+	var resourceFilePaths []string
+	for _, filePath := range filePaths {
+		if IsAttachmentFile(handler, filepath.Base(filePath)) {
+			resourceName := GetFileInfo(filePath).ResourceName
+			err := fmt.Errorf("file is an attachment of another resource file and is imported with it")
+			UpdateFailureSummary(resourceType, resourceName)
+			AddResourceResult(resourceType, resourceName, IMPORT, err, time.Now())
+			log.Printf("Invalid file for %s: %s. %s", resourceType, filePath, err)
+			continue
+		}
+		resourceFilePaths = append(resourceFilePaths, filePath)
+	}
+	if !validateFileReferences(handler, resourceFilePaths) {
+		log.Printf("Skipping the import of %s since some of the files refer to resources that do not exist.", resourceType)
+		return
+	}
+	localResources, _ := resolveLocalFiles(handler, resourceFilePaths, inventory)
+
+	for _, batch := range getImportBatches(handler, localResources) {
+		RunInParallel(len(batch), func(i int) {
+			err := ImportLocalResource(handler, inventory, batch[i])
+			if err != nil {
+				log.Printf("Error importing %s: %s", resourceType, err)
+			}
+		})
+	}
+}
+
+// resolveLocalFiles resolves the resources defined in the local files against the deployed resources. Invalid files
+// are recorded as failures, and the returned flag is false if any of the files is invalid.
+func resolveLocalFiles(handler ResourceHandler, filePaths []string, inventory *ResourceInventory) ([]LocalResource, bool) {
+
+	resourceType := handler.GetResourceType()
+	var localResources []LocalResource
+	allFilesResolved := true
+	for _, filePath := range filePaths {
+		if IsRunAborted() {
+			break
+		}
+		fileResources, err := ResolveLocalFile(handler, filePath, inventory)
+		if err != nil {
+			allFilesResolved = false
+			resourceName := GetFileInfo(filePath).ResourceName
+			UpdateFailureSummary(resourceType, resourceName)
+			AddResourceResult(resourceType, resourceName, IMPORT, fmt.Errorf("invalid file configurations: %s", err), time.Now())
+			log.Printf("Invalid file configurations for %s: %s. %s", resourceType, filepath.Base(filePath), err)
+			continue
+		}
+		localResources = append(localResources, fileResources...)
+	}
+	return localResources, allFilesResolved
+}
+
+// getImportBatches groups the local resources into batches that are imported one after the other.
+// Resources in the same batch are imported concurrently.
+func getImportBatches(handler ResourceHandler, localResources []LocalResource) [][]LocalResource {
+
+	orderer, ok := handler.(LocalFileOrderer)
+	if !ok {
+		return [][]LocalResource{localResources}
+	}
+
+	var batches [][]LocalResource
+	var batch, remaining []LocalResource
+	for _, localResource := range localResources {
+		if orderer.IsImportedFirst(filepath.Base(localResource.FilePath)) {
+			batch = append(batch, localResource)
+		} else {
+			remaining = append(remaining, localResource)
+		}
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return append(batches, remaining)
+}
+
+type LocalResource struct {
+	Resource Resource
+	FilePath string
+	FileData string
+}
+
+// ResolveLocalFile reads a local resource file, replaces the keyword placeholders and resolves the resources it defines.
+func ResolveLocalFile(handler ResourceHandler, filePath string, inventory *ResourceInventory) ([]LocalResource, error) {
+
+	fileData, err := readLocalFile(handler, filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	fileInfo := GetFileInfo(filePath)
+	resourcesData := [][]byte{[]byte(fileData)}
+	if splitter, ok := handler.(LocalFileSplitter); ok {
+		resourcesData, err = splitter.SplitLocalFile([]byte(fileData), fileInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var localResources []LocalResource
+	for _, resourceData := range resourcesData {
+		resource, err := handler.ResolveLocalResource(resourceData, fileInfo, inventory)
+		if err != nil {
+			return nil, err
+		}
+		localResources = append(localResources, LocalResource{
+			Resource: resource,
+			FilePath: filePath,
+			FileData: string(resourceData),
+		})
+	}
+	return localResources, nil
+}
+
+// readLocalFile reads a local resource file and replaces the keyword placeholders according to the keyword mappings
+// added in configs.
+func readLocalFile(handler ResourceHandler, filePath string) (string, error) {
+
+	fileBytes, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return "", fmt.Errorf("error when reading the file: %s", err)
+	}
+	keywordMapping := GetResourceKeywordMapping(handler, GetFileInfo(filePath).ResourceName)
+	return ReplaceKeywords(string(fileBytes), keywordMapping), nil
+}
+
+func ImportLocalResource(handler ResourceHandler, inventory *ResourceInventory, localResource LocalResource) error {
+
+	resourceType := handler.GetResourceType()
+	resource := localResource.Resource
+
+	if DRY_RUN {
+		if resource.Id == "" {
+			log.Printf("Resource will be created in %s: %s", resourceType, resource.Name)
+			AddToPlan(resourceType, resource.Name, IMPORT)
+		} else {
+			log.Printf("Resource will be updated in %s: %s", resourceType, resource.Name)
+			AddToPlan(resourceType, resource.Name, UPDATE)
+		}
+		return nil
+	}
+
+	startTime := time.Now()
+	if resource.Id == "" {
+		log.Printf("Creating new resource in %s: %s", resourceType, resource.Name)
+		resourceId, err := handler.ImportResource(resource, localResource.FilePath, localResource.FileData)
+		AddResourceResult(resourceType, resource.Name, IMPORT, err, startTime)
+		if resourceId != "" || err == nil {
+			// A resource can be created even if a later step of the import fails.
+			recordChange(handler, Resource{Id: resourceId, Name: resource.Name}, IMPORT)
+		}
+		if err != nil {
+			UpdateFailureSummary(resourceType, resource.Name)
+			return fmt.Errorf("error when importing %s: %s", resource.Name, err)
+		}
+		resource.Id = resourceId
+		updateInventoryAfterImport(resourceType, inventory, resource)
+		UpdateSuccessSummary(resourceType, IMPORT)
+		log.Printf("Resource imported successfully in %s: %s", resourceType, resource.Name)
+		return nil
+	}
+
+	log.Printf("Updating resource in %s: %s", resourceType, resource.Name)
+	recordChange(handler, resource, UPDATE)
+	err := handler.UpdateResource(resource, localResource.FilePath, localResource.FileData)
+	AddResourceResult(resourceType, resource.Name, UPDATE, err, startTime)
+	if err != nil {
+		UpdateFailureSummary(resourceType, resource.Name)
+		return fmt.Errorf("error when updating %s: %s", resource.Name, err)
+	}
+	inventory.Put(resource)
+	UpdateSuccessSummary(resourceType, UPDATE)
+	log.Printf("Resource updated successfully in %s: %s", resourceType, resource.Name)
+	return nil
+}
+
+func removeDeletedDeployedResources(handler ResourceHandler, inventory *ResourceInventory, localResources []LocalResource) {
+
+	// Remove deployed resources that do not exist locally.
+	resourceType := handler.GetResourceType()
+	resourceConfigs := GetResourceToolConfigs(handler)
+	var localResourceNames, localFileNames []string
+	for _, localResource := range localResources {
+		localResourceNames = append(localResourceNames, localResource.Resource.Name)
+		localFileNames = append(localFileNames, GetFileInfo(localResource.FilePath).ResourceName)
+	}
+
+	deployedResources := inventory.List()
+	RunInParallel(len(deployedResources), func(i int) {
+		resource := deployedResources[i]
+		if Contains(localResourceNames, resource.Name) || Contains(localFileNames, handler.GetFileName(resource)) {
+			return
+		}
+		if IsDeployedResourceExcluded(handler, resource, resourceConfigs) {
+			log.Printf("%s: %s is excluded from deletion.\n", resourceType, resource.Name)
+			AddSkippedResourceResult(resourceType, resource.Name, EXCLUDE, "Resource is excluded in the tool configs.")
+			return
+		}
+		if !handler.IsDeletable(resource) {
+			log.Printf("%s: %s is excluded from deletion.\n", resourceType, resource.Name)
+			AddSkippedResourceResult(resourceType, resource.Name, SKIP, "Resource cannot be deleted by the tool.")
+			return
+		}
+		if DRY_RUN {
+			AddToPlan(resourceType, resource.Name, DELETE)
+			return
+		}
+		log.Printf("Resource not found locally. Deleting %s: %s", resourceType, resource.Name)
+		startTime := time.Now()
+		err := handler.DeleteResource(resource)
+		AddResourceResult(resourceType, resource.Name, DELETE, err, startTime)
+		if err != nil {
+			UpdateFailureSummary(resourceType, resource.Name)
+			log.Printf("Error deleting %s: %s. %s", resourceType, resource.Name, err)
+			return
+		}
+		recordChange(handler, resource, DELETE)
+		inventory.Remove(resource)
+		UpdateSuccessSummary(resourceType, DELETE)
+	})
+}
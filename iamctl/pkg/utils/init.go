@@ -26,11 +26,14 @@ import (
 	"os"
 )
 
+// Version of the tool, set at build time.
+var TOOL_VERSION = "dev"
+
 var dir, _ = os.Getwd()
 var Path = dir + "/iamctl.json"
 var PathSampleSPDetails = dir + "/init.json"
 
-const SCOPE string = "internal_application_mgt_update internal_application_mgt_create internal_application_mgt_view internal_application_mgt_delete internal_idp_update internal_idp_create internal_idp_view internal_idp_delete internal_userstore_view internal_userstore_create internal_userstore_update internal_userstore_delete internal_claim_meta_create internal_claim_meta_view internal_claim_meta_update internal_claim_meta_delete"
+const SCOPE string = "internal_application_mgt_update internal_application_mgt_create internal_application_mgt_view internal_application_mgt_delete internal_idp_update internal_idp_create internal_idp_view internal_idp_delete internal_userstore_view internal_userstore_create internal_userstore_update internal_userstore_delete internal_claim_meta_create internal_claim_meta_view internal_claim_meta_update internal_claim_meta_delete internal_role_mgt_view internal_role_mgt_create internal_role_mgt_update internal_role_mgt_delete internal_group_mgt_view internal_group_mgt_create internal_group_mgt_update internal_group_mgt_delete internal_user_mgt_list internal_user_mgt_view internal_user_mgt_create internal_user_mgt_update internal_user_mgt_delete internal_oidc_scope_mgt_view internal_oidc_scope_mgt_create internal_oidc_scope_mgt_update internal_oidc_scope_mgt_delete internal_email_mgt_view internal_email_mgt_create internal_email_mgt_update internal_email_mgt_delete internal_template_mgt_view internal_template_mgt_create internal_template_mgt_update internal_template_mgt_delete internal_governance_view internal_governance_update internal_branding_preference_update internal_api_resource_view internal_api_resource_create internal_api_resource_update internal_api_resource_delete internal_organization_view internal_organization_create internal_organization_delete internal_list_tenants"
 
 const (
 	AppName       = "IAM-CTL"
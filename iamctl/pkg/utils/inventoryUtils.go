@@ -0,0 +1,183 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"log"
+	"sync"
+)
+
+// ResourceInventory holds the deployed resources of a resource type for the duration of a run.
+type ResourceInventory struct {
+	ids    []string
+	byId   map[string]Resource
+	byName map[string]string
+	mutex  sync.RWMutex
+}
+
+var (
+	inventories     = make(map[string]*ResourceInventory)
+	inventoriesLock sync.Mutex
+	// Set when only the deployed resources of the local files are needed in the run.
+	localResourcesOnly bool
+)
+
+// GetInventory returns the deployed resources of the given resource type. The resources are retrieved from the
+// server only once per run, and the inventory is updated with the resources created and deleted by the tool.
+func GetInventory(handler ResourceHandler) (*ResourceInventory, error) {
+
+	inventoriesLock.Lock()
+	defer inventoriesLock.Unlock()
+
+	resourceType := handler.GetResourceType()
+	if inventory, ok := inventories[resourceType]; ok {
+		return inventory, nil
+	}
+	resources, err := handler.GetDeployedResources()
+	if err != nil {
+		return nil, err
+	}
+	inventory := NewResourceInventory(resources)
+	inventories[resourceType] = inventory
+	return inventory, nil
+}
+
+// IsDeployedResourceListRequired returns false if only the deployed resources of the local files are needed in the
+// run, so that the handlers of resource types that are expensive to list can look up the local resources instead.
+func IsDeployedResourceListRequired() bool {
+
+	return !localResourcesOnly
+}
+
+// InvalidateInventory removes the cached inventory of the given resource type, so that it is retrieved again when needed.
+func InvalidateInventory(resourceType string) {
+
+	inventoriesLock.Lock()
+	defer inventoriesLock.Unlock()
+
+	delete(inventories, resourceType)
+}
+
+func ClearInventories() {
+
+	inventoriesLock.Lock()
+	defer inventoriesLock.Unlock()
+
+	inventories = make(map[string]*ResourceInventory)
+}
+
+func NewResourceInventory(resources []Resource) *ResourceInventory {
+
+	inventory := &ResourceInventory{
+		byId:   make(map[string]Resource),
+		byName: make(map[string]string),
+	}
+	for _, resource := range resources {
+		inventory.put(resource)
+	}
+	return inventory
+}
+
+// List returns the resources in the inventory in the order they were added.
+func (inventory *ResourceInventory) List() []Resource {
+
+	inventory.mutex.RLock()
+	defer inventory.mutex.RUnlock()
+
+	resources := make([]Resource, 0, len(inventory.ids))
+	for _, id := range inventory.ids {
+		resources = append(resources, inventory.byId[id])
+	}
+	return resources
+}
+
+func (inventory *ResourceInventory) GetByName(name string) (Resource, bool) {
+
+	inventory.mutex.RLock()
+	defer inventory.mutex.RUnlock()
+
+	id, ok := inventory.byName[name]
+	if !ok {
+		return Resource{}, false
+	}
+	return inventory.byId[id], true
+}
+
+func (inventory *ResourceInventory) GetById(id string) (Resource, bool) {
+
+	inventory.mutex.RLock()
+	defer inventory.mutex.RUnlock()
+
+	resource, ok := inventory.byId[id]
+	return resource, ok
+}
+
+// Put adds the given resource to the inventory, or replaces the resource with the same ID.
+func (inventory *ResourceInventory) Put(resource Resource) {
+
+	inventory.mutex.Lock()
+	defer inventory.mutex.Unlock()
+
+	inventory.put(resource)
+}
+
+func (inventory *ResourceInventory) Remove(resource Resource) {
+
+	inventory.mutex.Lock()
+	defer inventory.mutex.Unlock()
+
+	existing, ok := inventory.byId[resource.Id]
+	if !ok {
+		return
+	}
+	delete(inventory.byId, resource.Id)
+	if inventory.byName[existing.Name] == resource.Id {
+		delete(inventory.byName, existing.Name)
+	}
+	for i, id := range inventory.ids {
+		if id == resource.Id {
+			inventory.ids = append(inventory.ids[:i], inventory.ids[i+1:]...)
+			break
+		}
+	}
+}
+
+func (inventory *ResourceInventory) put(resource Resource) {
+
+	if existing, ok := inventory.byId[resource.Id]; ok {
+		if inventory.byName[existing.Name] == resource.Id {
+			delete(inventory.byName, existing.Name)
+		}
+	} else {
+		inventory.ids = append(inventory.ids, resource.Id)
+	}
+	inventory.byId[resource.Id] = resource
+	inventory.byName[resource.Name] = resource.Id
+}
+
+func updateInventoryAfterImport(resourceType string, inventory *ResourceInventory, resource Resource) {
+
+	if resource.Id == "" {
+		// The ID of the created resource is not known, hence retrieve the resources again when needed.
+		log.Printf("Created resource ID not returned for %s: %s. Inventory will be refreshed.", resourceType, resource.Name)
+		InvalidateInventory(resourceType)
+		return
+	}
+	inventory.Put(resource)
+}
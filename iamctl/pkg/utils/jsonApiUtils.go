@@ -0,0 +1,195 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"gopkg.in/yaml.v2"
+)
+
+const SCIM_PAGE_SIZE = 100
+
+type scimListResponse struct {
+	TotalResults int               `json:"totalResults"`
+	ItemsPerPage int               `json:"itemsPerPage"`
+	Resources    []json.RawMessage `json:"Resources"`
+}
+
+// GetServerBaseUrl returns the base URL of the tenant in the target environment, or the base URL of the organization
+// if an organization of the tenant is targeted.
+func GetServerBaseUrl() string {
+
+	baseUrl := SERVER_CONFIGS.ServerUrl + "/t/" + SERVER_CONFIGS.TenantDomain
+	if IsOrganizationContext() {
+		// The organization is resolved by the server from the organization switched access token.
+		return baseUrl + "/o"
+	}
+	return baseUrl
+}
+
+func GetScimBaseUrl() string {
+
+	return GetServerBaseUrl() + "/scim2/"
+}
+
+// SendJsonRequest sends a request with the given JSON body and returns the response body.
+// An error is returned if the response status is not successful.
+func SendJsonRequest(method string, reqUrl string, body interface{}, resourceType string) ([]byte, int, error) {
+
+	var requestBody []byte
+	if body != nil {
+		var err error
+		if requestBody, err = json.Marshal(body); err != nil {
+			return nil, 0, fmt.Errorf("error when creating the request body: %s", err)
+		}
+	}
+
+	request, err := http.NewRequest(method, reqUrl, bytes.NewReader(requestBody))
+	if err != nil {
+		return nil, 0, fmt.Errorf("error when creating the request: %s", err)
+	}
+	request.Header.Set("Content-Type", MEDIA_TYPE_JSON)
+	request.Header.Set("Accept", MEDIA_TYPE_JSON)
+	request.Header.Set("Authorization", "Bearer "+currentAccessToken())
+
+	resp, err := sendRequest(request, resourceType)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error when sending the request: %s", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.StatusCode, fmt.Errorf("error when reading the response: %s", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		if error, ok := ErrorCodes[resp.StatusCode]; ok {
+			return respBody, resp.StatusCode, fmt.Errorf("error response for the request. Status code: %d, Error: %s %s",
+				resp.StatusCode, error, string(respBody))
+		}
+		return respBody, resp.StatusCode, fmt.Errorf("unexpected error response for the request: %s %s", resp.Status, string(respBody))
+	}
+	return respBody, resp.StatusCode, nil
+}
+
+// GetScimResources retrieves all the resources of a SCIM2 endpoint, page by page.
+func GetScimResources(endpoint string, attributes string, resourceType string) ([]json.RawMessage, error) {
+
+	var resources []json.RawMessage
+	for startIndex := 1; ; startIndex += SCIM_PAGE_SIZE {
+		query := url.Values{}
+		query.Set("startIndex", strconv.Itoa(startIndex))
+		query.Set("count", strconv.Itoa(SCIM_PAGE_SIZE))
+		if attributes != "" {
+			query.Set("attributes", attributes)
+		}
+
+		body, _, err := SendJsonRequest(http.MethodGet, GetScimBaseUrl()+endpoint+"?"+query.Encode(), nil, resourceType)
+		if err != nil {
+			return nil, fmt.Errorf("error while retrieving %s list. %s", resourceType, err)
+		}
+		var list scimListResponse
+		if err := json.Unmarshal(body, &list); err != nil {
+			return nil, fmt.Errorf("error when unmarshalling the retrieved %s list. %s", resourceType, err)
+		}
+		resources = append(resources, list.Resources...)
+		if len(list.Resources) == 0 || len(resources) >= list.TotalResults {
+			return resources, nil
+		}
+	}
+}
+
+// GetScimResourceId returns the ID of the resource of a SCIM2 endpoint that matches the given filter.
+// An empty ID is returned if a matching resource is not found.
+func GetScimResourceId(endpoint string, filter string, resourceType string) (string, error) {
+
+	query := url.Values{}
+	query.Set("filter", filter)
+	query.Set("attributes", "id")
+
+	body, _, err := SendJsonRequest(http.MethodGet, GetScimBaseUrl()+endpoint+"?"+query.Encode(), nil, resourceType)
+	if err != nil {
+		return "", err
+	}
+	var list scimListResponse
+	if err := json.Unmarshal(body, &list); err != nil {
+		return "", fmt.Errorf("error when unmarshalling the retrieved %s list. %s", resourceType, err)
+	}
+	if len(list.Resources) == 0 {
+		return "", nil
+	}
+	var resource struct {
+		Id string `json:"id"`
+	}
+	if err := json.Unmarshal(list.Resources[0], &resource); err != nil {
+		return "", fmt.Errorf("error when unmarshalling the retrieved %s. %s", resourceType, err)
+	}
+	return resource.Id, nil
+}
+
+// JsonToYaml converts a JSON resource to YAML, removing the given fields that are specific to an environment.
+func JsonToYaml(jsonContent []byte, excludedFields ...string) ([]byte, error) {
+
+	var content map[string]interface{}
+	if err := json.Unmarshal(jsonContent, &content); err != nil {
+		return nil, fmt.Errorf("error when parsing the JSON content: %s", err)
+	}
+	for _, field := range excludedFields {
+		delete(content, field)
+	}
+	return yaml.Marshal(content)
+}
+
+// YamlToMap converts a YAML resource to a map that can be marshalled to JSON.
+func YamlToMap(yamlContent []byte) (map[string]interface{}, error) {
+
+	var content interface{}
+	if err := yaml.Unmarshal(yamlContent, &content); err != nil {
+		return nil, fmt.Errorf("error when parsing the YAML content: %s", err)
+	}
+	converted, ok := convertYamlValue(content).(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid content. Expected a YAML object")
+	}
+	return converted, nil
+}
+
+func convertYamlValue(value interface{}) interface{} {
+
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			converted[fmt.Sprintf("%v", key)] = convertYamlValue(val)
+		}
+		return converted
+	case []interface{}:
+		for i, val := range v {
+			v[i] = convertYamlValue(val)
+		}
+	}
+	return value
+}
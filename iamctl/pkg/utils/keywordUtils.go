@@ -152,6 +152,9 @@ func GetArrayIdentifiers(resourceType string) map[string]string {
 	case CLAIMS:
 		return claimArrayIdentifiers
 	}
+	if handler := GetResourceHandler(resourceType); handler != nil {
+		return handler.GetArrayIdentifiers()
+	}
 	return make(map[string]string)
 }
 
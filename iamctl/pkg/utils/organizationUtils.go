@@ -0,0 +1,328 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const ORGANIZATION_PAGE_SIZE = 100
+
+type Organization struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type organizationList struct {
+	Organizations []Organization `json:"organizations"`
+	Links         []struct {
+		Href string `json:"href"`
+		Rel  string `json:"rel"`
+	} `json:"links"`
+}
+
+// IsOrganizationContext returns true if an organization of the tenant is targeted instead of the tenant itself.
+func IsOrganizationContext() bool {
+
+	return SERVER_CONFIGS.OrganizationId != ""
+}
+
+// SwitchOrganization targets the given organization in the subsequent requests, or the tenant if the organization ID
+// is empty. The cached inventories are cleared since they belong to the previously targeted organization.
+func SwitchOrganization(organizationId string) error {
+
+	tokenMutex.Lock()
+	SERVER_CONFIGS.OrganizationId = organizationId
+	err := refreshAccessToken()
+	tokenMutex.Unlock()
+
+	ClearInventories()
+	return err
+}
+
+// GetSubOrganizations returns the immediate child organizations of the targeted organization, or all the organizations
+// under it if recursive.
+func GetSubOrganizations(recursive bool) ([]Organization, error) {
+
+	var organizations []Organization
+	after := ""
+	for {
+		query := url.Values{}
+		query.Set("recursive", fmt.Sprint(recursive))
+		query.Set("limit", fmt.Sprint(ORGANIZATION_PAGE_SIZE))
+		if after != "" {
+			query.Set("after", after)
+		}
+		body, _, err := SendJsonRequest(http.MethodGet, getOrganizationsUrl()+"?"+query.Encode(), nil, ORGANIZATIONS)
+		if err != nil {
+			return nil, fmt.Errorf("error while retrieving organization list. %w", err)
+		}
+		var list organizationList
+		if err := json.Unmarshal(body, &list); err != nil {
+			return nil, fmt.Errorf("error when unmarshalling the retrieved organization list. %w", err)
+		}
+		organizations = append(organizations, list.Organizations...)
+
+		// Continue with the cursor of the next page, if available.
+		after = ""
+		for _, link := range list.Links {
+			if link.Rel != "next" {
+				continue
+			}
+			if nextUrl, err := url.Parse(link.Href); err == nil {
+				after = nextUrl.Query().Get("after")
+			}
+		}
+		if after == "" || len(list.Organizations) == 0 {
+			return organizations, nil
+		}
+	}
+}
+
+func createOrganization(name string) (Organization, error) {
+
+	body, _, err := SendJsonRequest(http.MethodPost, getOrganizationsUrl(), map[string]string{"name": name}, ORGANIZATIONS)
+	if err != nil {
+		return Organization{}, err
+	}
+	var organization Organization
+	if err := json.Unmarshal(body, &organization); err != nil {
+		return Organization{}, fmt.Errorf("error when unmarshalling the created organization. %w", err)
+	}
+	return organization, nil
+}
+
+func getOrganizationsUrl() string {
+
+	return GetServerBaseUrl() + "/api/server/v1/organizations"
+}
+
+// ExportAllOrganizations exports the resources of the targeted organization to the output directory, and the resources
+// of each sub organization to a separate folder under the Organizations folder of its parent organization.
+func ExportAllOrganizations(outputDirPath string, format string) {
+
+	ExportAllResources(outputDirPath, format)
+	exportSubOrganizations(outputDirPath, format)
+}
+
+func exportSubOrganizations(outputDirPath string, format string) {
+
+	organizations, err := GetSubOrganizations(false)
+	if err != nil {
+		UpdateFailureSummary(ORGANIZATIONS, ORGANIZATIONS)
+		log.Printf("Error: when exporting %s. %s", ORGANIZATIONS, err)
+		return
+	}
+
+	parentId := SERVER_CONFIGS.OrganizationId
+	defer restoreOrganization(parentId)
+	for _, organization := range organizations {
+		if IsRunAborted() {
+			return
+		}
+		if err := SwitchOrganization(organization.Id); err != nil {
+			UpdateFailureSummary(ORGANIZATIONS, organization.Name)
+			log.Printf("Error when switching to the organization: %s. %s", organization.Name, err)
+			continue
+		}
+		log.Printf("Exporting the resources of the organization: %s", organization.Name)
+		organizationDirPath := getOrganizationDirPath(outputDirPath, organization.Name)
+		ExportAllOrganizations(organizationDirPath, format)
+	}
+}
+
+// ImportAllOrganizations imports the resources of the targeted organization from the input directory, and the
+// resources of each sub organization from the Organizations folder of its parent organization. The sub organizations
+// that do not exist in the target environment are created first, so that the applications can be shared with them.
+func ImportAllOrganizations(inputDirPath string) {
+
+	failedOperations := getFailedOperationCount()
+	var createdOrganizations []createdOrganization
+	if !DRY_RUN {
+		createdOrganizations = createSubOrganizations(inputDirPath)
+	}
+	ImportAllResources(inputDirPath)
+	importSubOrganizations(inputDirPath)
+
+	if ROLLBACK_ON_FAILURE && getFailedOperationCount() > failedOperations {
+		deleteCreatedOrganizations(createdOrganizations)
+	}
+}
+
+// createdOrganization is a sub organization created by an import, along with the ID of its parent organization.
+type createdOrganization struct {
+	Organization Organization
+	ParentId     string
+}
+
+// createSubOrganizations creates the sub organizations in the input directory that do not exist in the target
+// environment, and returns the created organizations in the order they were created.
+func createSubOrganizations(inputDirPath string) []createdOrganization {
+
+	organizations, err := resolveLocalOrganizations(inputDirPath)
+	if err != nil {
+		UpdateFailureSummary(ORGANIZATIONS, ORGANIZATIONS)
+		log.Printf("Error importing %s: %s", ORGANIZATIONS, err)
+		return nil
+	}
+
+	var createdOrganizations []createdOrganization
+	parentId := SERVER_CONFIGS.OrganizationId
+	defer restoreOrganization(parentId)
+	for _, organization := range organizations {
+		if IsRunAborted() {
+			break
+		}
+		if organization.Id == "" {
+			log.Printf("Creating new organization: %s", organization.Name)
+			restoreOrganization(parentId)
+			created, err := createOrganization(organization.Name)
+			if err != nil {
+				UpdateFailureSummary(ORGANIZATIONS, organization.Name)
+				log.Printf("Error when creating the organization: %s. %s", organization.Name, err)
+				continue
+			}
+			organization = created
+			createdOrganizations = append(createdOrganizations, createdOrganization{Organization: created, ParentId: parentId})
+			UpdateSuccessSummary(ORGANIZATIONS, IMPORT)
+		}
+		if err := SwitchOrganization(organization.Id); err != nil {
+			UpdateFailureSummary(ORGANIZATIONS, organization.Name)
+			log.Printf("Error when switching to the organization: %s. %s", organization.Name, err)
+			continue
+		}
+		subOrganizations := createSubOrganizations(getOrganizationDirPath(inputDirPath, organization.Name))
+		createdOrganizations = append(createdOrganizations, subOrganizations...)
+	}
+	return createdOrganizations
+}
+
+// deleteCreatedOrganizations deletes the sub organizations created by the import in the reverse order, so that the
+// child organizations are deleted before their parent organizations.
+func deleteCreatedOrganizations(createdOrganizations []createdOrganization) {
+
+	if len(createdOrganizations) == 0 {
+		return
+	}
+	defer restoreOrganization(SERVER_CONFIGS.OrganizationId)
+	log.Println("Deleting the sub organizations created by the import.")
+	for i := len(createdOrganizations) - 1; i >= 0; i-- {
+		created := createdOrganizations[i]
+		startTime := time.Now()
+		err := SwitchOrganization(created.ParentId)
+		if err == nil {
+			_, _, err = SendJsonRequest(http.MethodDelete, getOrganizationsUrl()+"/"+created.Organization.Id, nil, ORGANIZATIONS)
+		}
+		AddResourceResult(ORGANIZATIONS, created.Organization.Name, ROLLBACK, err, startTime)
+		if err != nil {
+			log.Printf("Error when deleting the organization: %s. %s", created.Organization.Name, err)
+		} else {
+			log.Printf("Organization deleted successfully: %s", created.Organization.Name)
+		}
+	}
+}
+
+func importSubOrganizations(inputDirPath string) {
+
+	organizations, err := resolveLocalOrganizations(inputDirPath)
+	if err != nil {
+		UpdateFailureSummary(ORGANIZATIONS, ORGANIZATIONS)
+		log.Printf("Error importing %s: %s", ORGANIZATIONS, err)
+		return
+	}
+
+	parentId := SERVER_CONFIGS.OrganizationId
+	defer restoreOrganization(parentId)
+	for _, organization := range organizations {
+		if IsRunAborted() {
+			return
+		}
+		if organization.Id == "" {
+			// Only possible in a dry run, since the missing organizations are created before importing the resources.
+			log.Printf("Organization will be created: %s", organization.Name)
+			AddToPlan(ORGANIZATIONS, organization.Name, IMPORT)
+			continue
+		}
+		if err := SwitchOrganization(organization.Id); err != nil {
+			UpdateFailureSummary(ORGANIZATIONS, organization.Name)
+			log.Printf("Error when switching to the organization: %s. %s", organization.Name, err)
+			continue
+		}
+		log.Printf("Importing the resources of the organization: %s", organization.Name)
+		organizationDirPath := getOrganizationDirPath(inputDirPath, organization.Name)
+		ImportAllResources(organizationDirPath)
+		importSubOrganizations(organizationDirPath)
+	}
+}
+
+// resolveLocalOrganizations returns the sub organizations that have a folder in the Organizations folder of the given
+// directory, along with the IDs of the matching child organizations of the targeted organization.
+func resolveLocalOrganizations(dirPath string) ([]Organization, error) {
+
+	organizationsDirPath := filepath.Join(dirPath, ORGANIZATIONS)
+	if _, err := os.Stat(organizationsDirPath); os.IsNotExist(err) {
+		return nil, nil
+	}
+	files, err := ioutil.ReadDir(organizationsDirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	deployedOrganizations, err := GetSubOrganizations(false)
+	if err != nil {
+		return nil, err
+	}
+	var organizations []Organization
+	for _, file := range files {
+		if !file.IsDir() {
+			continue
+		}
+		organization := Organization{Name: file.Name()}
+		for _, deployedOrganization := range deployedOrganizations {
+			if deployedOrganization.Name == organization.Name {
+				organization.Id = deployedOrganization.Id
+			}
+		}
+		organizations = append(organizations, organization)
+	}
+	return organizations, nil
+}
+
+func getOrganizationDirPath(parentDirPath string, organizationName string) string {
+
+	return filepath.Join(parentDirPath, ORGANIZATIONS, organizationName)
+}
+
+func restoreOrganization(organizationId string) {
+
+	if SERVER_CONFIGS.OrganizationId == organizationId {
+		return
+	}
+	if err := SwitchOrganization(organizationId); err != nil {
+		ExitWithError(EXIT_CODE_AUTH_ERROR, "Error when switching back to the parent organization.", err)
+	}
+}
@@ -0,0 +1,60 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"sync"
+)
+
+const DEFAULT_PARALLELISM = 1
+
+// Number of resources of the same type that are processed concurrently.
+var PARALLELISM = DEFAULT_PARALLELISM
+
+// RunInParallel runs the given task for each index up to count, with at most PARALLELISM tasks running at a time.
+// It returns after all the started tasks are completed. No new tasks are started once the run is aborted.
+func RunInParallel(count int, task func(index int)) {
+
+	workers := PARALLELISM
+	if workers < 1 {
+		workers = DEFAULT_PARALLELISM
+	}
+	if workers > count {
+		workers = count
+	}
+
+	indexes := make(chan int)
+	var waitGroup sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for index := range indexes {
+				if !IsRunAborted() {
+					task(index)
+				}
+			}
+		}()
+	}
+	for i := 0; i < count && !IsRunAborted(); i++ {
+		indexes <- i
+	}
+	close(indexes)
+	waitGroup.Wait()
+}
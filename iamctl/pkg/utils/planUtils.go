@@ -0,0 +1,111 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+type ResourcePlan struct {
+	ResourceType string
+	Tenant       string
+	ToCreate     []string
+	ToUpdate     []string
+	ToDelete     []string
+}
+
+var (
+	DRY_RUN       bool
+	ResourcePlans map[string]ResourcePlan
+	planOrder     []string
+	planMutex     sync.Mutex
+)
+
+// AddToPlan records an operation that would be done by the import, and includes it in the report as a skipped result.
+func AddToPlan(resourceType string, resourceName string, operation string) {
+
+	AddSkippedResourceResult(resourceType, resourceName, operation, "Dry run. No changes were made to the target environment.")
+
+	planMutex.Lock()
+	defer planMutex.Unlock()
+
+	if ResourcePlans == nil {
+		ResourcePlans = make(map[string]ResourcePlan)
+	}
+
+	// Plans are kept separately for each tenant, as the resource summaries.
+	planKey := getSummaryKey(resourceType)
+	plan, ok := ResourcePlans[planKey]
+	if !ok {
+		plan = ResourcePlan{
+			ResourceType: resourceType,
+			Tenant:       currentTenant,
+		}
+		planOrder = append(planOrder, planKey)
+	}
+	switch operation {
+	case IMPORT:
+		plan.ToCreate = append(plan.ToCreate, resourceName)
+	case UPDATE:
+		plan.ToUpdate = append(plan.ToUpdate, resourceName)
+	case DELETE:
+		plan.ToDelete = append(plan.ToDelete, resourceName)
+	}
+	ResourcePlans[planKey] = plan
+}
+
+func PrintPlan() {
+
+	var totalCreates, totalUpdates, totalDeletes int
+	for _, plan := range ResourcePlans {
+		totalCreates += len(plan.ToCreate)
+		totalUpdates += len(plan.ToUpdate)
+		totalDeletes += len(plan.ToDelete)
+	}
+
+	fmt.Println("========================================")
+	fmt.Println("Import Plan (dry run):")
+	fmt.Println("========================================")
+	fmt.Printf("To be created: %d\n", totalCreates)
+	fmt.Printf("To be updated: %d\n", totalUpdates)
+	fmt.Printf("To be deleted: %d\n", totalDeletes)
+
+	for _, planKey := range planOrder {
+		plan := ResourcePlans[planKey]
+		fmt.Println("----------------------------------------")
+		if plan.Tenant != "" {
+			fmt.Printf("%s (Tenant: %s)\n", plan.ResourceType, plan.Tenant)
+		} else {
+			fmt.Printf("%s\n", plan.ResourceType)
+		}
+		fmt.Println("----------------------------------------")
+		printPlannedResources("Create", plan.ToCreate)
+		printPlannedResources("Update", plan.ToUpdate)
+		printPlannedResources("Delete", plan.ToDelete)
+	}
+	fmt.Println("----------------------------------------")
+	fmt.Println("No changes were made to the target environment.")
+}
+
+func printPlannedResources(operation string, resourceNames []string) {
+
+	fmt.Printf("%s (%d): %s\n", operation, len(resourceNames), strings.Join(resourceNames, ", "))
+}
@@ -0,0 +1,236 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// InvalidReference is a reference in a local file to a resource that neither exists locally nor in the target
+// environment.
+type InvalidReference struct {
+	ResourceType   string
+	ResourceName   string
+	ReferencedType string
+	ReferencedName string
+}
+
+func (r InvalidReference) Error() string {
+
+	return fmt.Sprintf("%s: %s refers to %s: %s, which does not exist locally or in the target environment",
+		r.ResourceType, r.ResourceName, r.ReferencedType, r.ReferencedName)
+}
+
+// validateReferences records a failure for each local resource of the given handlers in the input directory that refers
+// to a resource that does not exist, and returns false if there are any.
+func validateReferences(handlers []ResourceHandler, inputDirPath string) bool {
+
+	return recordInvalidReferences(GetInvalidReferences(handlers, inputDirPath))
+}
+
+// validateFileReferences records a failure for each resource in the given local files of the handler that refers to a
+// resource that does not exist, and returns false if there are any.
+func validateFileReferences(handler ResourceHandler, filePaths []string) bool {
+
+	localFiles := map[string][]string{handler.GetResourceType(): filePaths}
+	return recordInvalidReferences(getInvalidReferences(GetResourceHandlers(), localFiles, false))
+}
+
+func recordInvalidReferences(invalidReferences []InvalidReference) bool {
+
+	failedResources := make(map[string]bool)
+	for _, reference := range invalidReferences {
+		log.Printf("Invalid reference: %s", reference.Error())
+		resourceKey := reference.ResourceType + "/" + reference.ResourceName
+		if failedResources[resourceKey] {
+			continue
+		}
+		failedResources[resourceKey] = true
+		UpdateFailureSummary(reference.ResourceType, reference.ResourceName)
+		AddResourceResult(reference.ResourceType, reference.ResourceName, IMPORT, reference, time.Now())
+	}
+	return len(invalidReferences) == 0
+}
+
+// GetInvalidReferences returns the references in the local files of the given handlers in the input directory to
+// resources that neither exist locally nor in the target environment. Local resources excluded by the INCLUDE_ONLY or
+// EXCLUDE configs are neither validated nor referenceable. References to a resource type are not validated if it is
+// not handled by the given handlers, or if the deployed resources of the type cannot be retrieved, since the import of
+// the type fails in that case.
+func GetInvalidReferences(handlers []ResourceHandler, inputDirPath string) []InvalidReference {
+
+	localFiles := make(map[string][]string)
+	for _, handler := range handlers {
+		if !isResourceTypeExcluded(handler.GetResourceType()) {
+			localFiles[handler.GetResourceType()] = getLocalResourceFiles(handler, inputDirPath)
+		}
+	}
+	return getInvalidReferences(handlers, localFiles, true)
+}
+
+// getInvalidReferences returns the references in the given local files of each resource type to resources that
+// neither exist in the local files nor in the target environment. Excluded local resources are skipped if applyFilters
+// is true.
+func getInvalidReferences(handlers []ResourceHandler, localFiles map[string][]string, applyFilters bool) []InvalidReference {
+
+	handlersByType := make(map[string]ResourceHandler)
+	for _, handler := range handlers {
+		handlersByType[handler.GetResourceType()] = handler
+	}
+
+	var invalidReferences []InvalidReference
+	referenceableNames := make(map[string]map[string]bool)
+	for _, handler := range handlers {
+		resolver, ok := handler.(ReferenceResolver)
+		if !ok {
+			continue
+		}
+		for _, localResource := range getLocalResources(handler, localFiles[handler.GetResourceType()], applyFilters) {
+			// Invalid local files are reported when they are imported.
+			references, err := resolver.GetReferences([]byte(localResource.FileData))
+			if err != nil {
+				continue
+			}
+			for referencedType, referencedNames := range references {
+				names, ok := referenceableNames[referencedType]
+				if !ok {
+					names = getReferenceableNames(handlersByType[referencedType], localFiles[referencedType], applyFilters)
+					referenceableNames[referencedType] = names
+				}
+				if names == nil {
+					continue
+				}
+				for _, referencedName := range referencedNames {
+					if referencedName != "" && !names[referencedName] {
+						invalidReferences = append(invalidReferences, InvalidReference{
+							ResourceType:   handler.GetResourceType(),
+							ResourceName:   localResource.Resource.Name,
+							ReferencedType: referencedType,
+							ReferencedName: referencedName,
+						})
+					}
+				}
+			}
+		}
+	}
+	return invalidReferences
+}
+
+// getLocalResources resolves the resources defined in the given local files of the handler, skipping the files that
+// cannot be resolved and the resources excluded by the INCLUDE_ONLY or EXCLUDE configs if applyFilters is true.
+func getLocalResources(handler ResourceHandler, filePaths []string, applyFilters bool) []LocalResource {
+
+	if len(filePaths) == 0 {
+		return nil
+	}
+	inventory, err := GetInventory(handler)
+	if err != nil {
+		return nil
+	}
+	resourceConfigs := GetResourceToolConfigs(handler)
+	var localResources []LocalResource
+	for _, filePath := range filePaths {
+		fileResources, err := ResolveLocalFile(handler, filePath, inventory)
+		if err != nil {
+			continue
+		}
+		for _, localResource := range fileResources {
+			if applyFilters && IsLocalResourceExcluded(handler, localResource, resourceConfigs) {
+				continue
+			}
+			localResources = append(localResources, localResource)
+		}
+	}
+	return localResources
+}
+
+// getReferenceableNames returns the names by which the resources of the handler can be referred to, including the
+// resources in the given local files that are imported and the deployed resources. Returns nil if the names cannot be
+// resolved.
+func getReferenceableNames(handler ResourceHandler, filePaths []string, applyFilters bool) map[string]bool {
+
+	if handler == nil {
+		return nil
+	}
+	resourceType := handler.GetResourceType()
+	provider, isProvider := handler.(ReferenceProvider)
+
+	names := make(map[string]bool)
+	var deployedNames []string
+	inventory, err := GetInventory(handler)
+	if err != nil {
+		log.Printf("Skipping the validation of the references to %s since the deployed resources cannot be retrieved. %s",
+			resourceType, err)
+		return nil
+	}
+	if isProvider {
+		if deployedNames, err = provider.GetDeployedReferenceNames(); err != nil {
+			log.Printf("Skipping the validation of the references to %s. %s", resourceType, err)
+			return nil
+		}
+	} else {
+		for _, resource := range inventory.List() {
+			deployedNames = append(deployedNames, resource.Name)
+		}
+	}
+	for _, name := range deployedNames {
+		names[name] = true
+	}
+
+	for _, localResource := range getLocalResources(handler, filePaths, applyFilters) {
+		if !isProvider {
+			names[localResource.Resource.Name] = true
+			continue
+		}
+		localNames, err := provider.GetLocalReferenceNames([]byte(localResource.FileData))
+		if err != nil {
+			continue
+		}
+		for _, name := range localNames {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+// getLocalResourceFiles returns the paths of the local resource files of the handler in the input directory.
+func getLocalResourceFiles(handler ResourceHandler, inputDirPath string) []string {
+
+	dirPath := filepath.Join(inputDirPath, handler.GetResourceType())
+	if _, err := os.Stat(dirPath); os.IsNotExist(err) {
+		return nil
+	}
+	files, err := ioutil.ReadDir(dirPath)
+	if err != nil {
+		return nil
+	}
+	var filePaths []string
+	for _, file := range files {
+		if file.IsDir() || IsAttachmentFile(handler, file.Name()) {
+			continue
+		}
+		filePaths = append(filePaths, filepath.Join(dirPath, file.Name()))
+	}
+	return filePaths
+}
@@ -0,0 +1,293 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const REPORT_FORMAT_JSON = "json"
+const REPORT_FORMAT_JUNIT = "junit"
+
+const SKIP = "skip"
+const EXCLUDE = "exclude"
+
+const OUTCOME_SUCCESS = "success"
+const OUTCOME_FAILURE = "failure"
+const OUTCOME_SKIPPED = "skipped"
+
+type ResourceResult struct {
+	Tenant       string  `json:"tenant,omitempty"`
+	ResourceType string  `json:"resourceType"`
+	ResourceName string  `json:"resourceName"`
+	Operation    string  `json:"operation"`
+	Outcome      string  `json:"outcome"`
+	Error        string  `json:"error,omitempty"`
+	Duration     float64 `json:"duration"`
+}
+
+type Report struct {
+	Operation                   string           `json:"operation"`
+	StartTime                   time.Time        `json:"startTime"`
+	Duration                    float64          `json:"duration"`
+	Summary                     Summary          `json:"summary"`
+	SecretGeneratedApplications []string         `json:"secretGeneratedApplications"`
+	Resources                   []ResourceResult `json:"resources"`
+}
+
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	Name       string           `xml:"name,attr"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	Skipped    int              `xml:"skipped,attr"`
+	Time       string           `xml:"time,attr"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Time       string          `xml:"time,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	TestCases  []junitTestCase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+}
+
+var (
+	ResourceResults []ResourceResult
+	runStartTime    = time.Now()
+	resultsMutex    sync.Mutex
+)
+
+// AddResourceResult records the outcome of an operation on a resource, to be included in the report.
+func AddResourceResult(resourceType string, resourceName string, operation string, err error, startTime time.Time) {
+
+	result := ResourceResult{
+		Tenant:       currentTenant,
+		ResourceType: resourceType,
+		ResourceName: resourceName,
+		Operation:    operation,
+		Outcome:      OUTCOME_SUCCESS,
+		Duration:     time.Since(startTime).Seconds(),
+	}
+	if err != nil {
+		result.Outcome = OUTCOME_FAILURE
+		result.Error = err.Error()
+	}
+	addResult(result)
+}
+
+// AddSkippedResourceResult records a resource that was not processed, with the reason to be included in the report.
+func AddSkippedResourceResult(resourceType string, resourceName string, operation string, reason string) {
+
+	addResult(ResourceResult{
+		Tenant:       currentTenant,
+		ResourceType: resourceType,
+		ResourceName: resourceName,
+		Operation:    operation,
+		Outcome:      OUTCOME_SKIPPED,
+		Error:        reason,
+	})
+}
+
+func addResult(result ResourceResult) {
+
+	resultsMutex.Lock()
+	defer resultsMutex.Unlock()
+
+	ResourceResults = append(ResourceResults, result)
+}
+
+// WriteReport writes a machine readable report of the run in the given format to the given file.
+func WriteReport(reportFormat string, reportFile string, operation string) error {
+
+	if reportFormat == "" && reportFile == "" {
+		return nil
+	}
+	if reportFormat == "" {
+		reportFormat = REPORT_FORMAT_JSON
+		if strings.HasSuffix(strings.ToLower(reportFile), ".xml") {
+			reportFormat = REPORT_FORMAT_JUNIT
+		}
+	}
+
+	report := buildReport(operation)
+	var content []byte
+	var err error
+	switch reportFormat {
+	case REPORT_FORMAT_JSON:
+		if reportFile == "" {
+			reportFile = "iamctl-report.json"
+		}
+		content, err = json.MarshalIndent(report, "", "  ")
+	case REPORT_FORMAT_JUNIT:
+		if reportFile == "" {
+			reportFile = "iamctl-report.xml"
+		}
+		content, err = xml.MarshalIndent(buildJUnitReport(report), "", "  ")
+		content = append([]byte(xml.Header), content...)
+	default:
+		return ValidateReportFormat(reportFormat)
+	}
+	if err != nil {
+		return fmt.Errorf("error when creating the report: %s", err)
+	}
+
+	err = ioutil.WriteFile(reportFile, content, 0644)
+	if err != nil {
+		return fmt.Errorf("error when writing the report to file: %s", err)
+	}
+	log.Printf("Report written to %s", reportFile)
+	return nil
+}
+
+func ValidateReportFormat(reportFormat string) error {
+
+	if reportFormat != "" && reportFormat != REPORT_FORMAT_JSON && reportFormat != REPORT_FORMAT_JUNIT {
+		return fmt.Errorf("unsupported report format: %s. Supported formats are %s and %s", reportFormat,
+			REPORT_FORMAT_JSON, REPORT_FORMAT_JUNIT)
+	}
+	return nil
+}
+
+func buildReport(operation string) Report {
+
+	resultsMutex.Lock()
+	resources := make([]ResourceResult, len(ResourceResults))
+	copy(resources, ResourceResults)
+	resultsMutex.Unlock()
+
+	summaryMutex.Lock()
+	summary := SummaryData
+	secretGeneratedApps := []string{}
+	for _, resourceSummary := range ResourceSummaries {
+		if resourceSummary.ResourceType == APPLICATIONS {
+			secretGeneratedApps = append(secretGeneratedApps, resourceSummary.SecretGeneratedApplications...)
+		}
+	}
+	summaryMutex.Unlock()
+
+	// Sort the results since resources are processed concurrently.
+	sort.SliceStable(resources, func(i, j int) bool {
+		if resources[i].Tenant != resources[j].Tenant {
+			return resources[i].Tenant < resources[j].Tenant
+		}
+		if resources[i].ResourceType != resources[j].ResourceType {
+			return resources[i].ResourceType < resources[j].ResourceType
+		}
+		return resources[i].ResourceName < resources[j].ResourceName
+	})
+
+	return Report{
+		Operation:                   operation,
+		StartTime:                   runStartTime,
+		Duration:                    time.Since(runStartTime).Seconds(),
+		Summary:                     summary,
+		SecretGeneratedApplications: secretGeneratedApps,
+		Resources:                   resources,
+	}
+}
+
+func buildJUnitReport(report Report) junitTestSuites {
+
+	testSuites := junitTestSuites{
+		Name: "iamctl " + report.Operation,
+		Time: formatSeconds(report.Duration),
+	}
+	suiteIndexes := make(map[string]int)
+	suiteDurations := make(map[string]float64)
+	for _, result := range report.Resources {
+		// Test suites are separated by the tenant when multiple tenants are processed in a single run.
+		suiteName := result.ResourceType
+		if result.Tenant != "" {
+			suiteName = result.Tenant + "/" + result.ResourceType
+		}
+		index, ok := suiteIndexes[suiteName]
+		if !ok {
+			index = len(testSuites.TestSuites)
+			suiteIndexes[suiteName] = index
+			testSuites.TestSuites = append(testSuites.TestSuites, junitTestSuite{Name: suiteName})
+		}
+		suite := &testSuites.TestSuites[index]
+
+		testCase := junitTestCase{
+			Name:      result.Operation + ": " + result.ResourceName,
+			ClassName: suiteName,
+			Time:      formatSeconds(result.Duration),
+		}
+		switch result.Outcome {
+		case OUTCOME_FAILURE:
+			testCase.Failure = &junitMessage{Message: result.Error}
+			suite.Failures++
+			testSuites.Failures++
+		case OUTCOME_SKIPPED:
+			testCase.Skipped = &junitMessage{Message: result.Error}
+			suite.Skipped++
+			testSuites.Skipped++
+		}
+		suite.Tests++
+		testSuites.Tests++
+		suiteDurations[suiteName] += result.Duration
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+
+	for i := range testSuites.TestSuites {
+		suite := &testSuites.TestSuites[i]
+		suite.Time = formatSeconds(suiteDurations[suite.Name])
+		if suite.Name == APPLICATIONS && len(report.SecretGeneratedApplications) > 0 {
+			suite.Properties = append(suite.Properties, junitProperty{
+				Name:  "secretGeneratedApplications",
+				Value: strings.Join(report.SecretGeneratedApplications, ","),
+			})
+		}
+	}
+	return testSuites
+}
+
+func formatSeconds(seconds float64) string {
+
+	return fmt.Sprintf("%.3f", seconds)
+}
@@ -0,0 +1,210 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+)
+
+type Resource struct {
+	Id   string
+	Name string
+}
+
+// ResourceHandler provides the resource type specific operations used to export, import and compare resources.
+type ResourceHandler interface {
+	// GetResourceType returns the resource type, which is also used as the folder name of the resource files.
+	GetResourceType() string
+	// GetConfigKey returns the key of the resource type specific configs in the tool and keyword configs.
+	GetConfigKey() string
+	// GetDependencies returns the resource types that should be imported before this resource type.
+	GetDependencies() []string
+	// GetArrayIdentifiers returns the fields used to identify the elements of each array in a resource file.
+	GetArrayIdentifiers() map[string]string
+	GetDeployedResources() ([]Resource, error)
+	// GetFileName returns the name of the local file of a deployed resource, without the file extension.
+	GetFileName(resource Resource) string
+	// ExportResource returns the name of the exported file and its content as returned by the server.
+	ExportResource(resource Resource, format string, excludeSecrets bool) (string, []byte, error)
+	// ResolveLocalResource returns the resource defined in a local file, with the ID set if it is already deployed.
+	ResolveLocalResource(fileData []byte, fileInfo FileInfo, inventory *ResourceInventory) (Resource, error)
+	// ImportResource creates the resource and returns its ID, if it is returned by the server.
+	ImportResource(resource Resource, filePath string, fileData string) (string, error)
+	UpdateResource(resource Resource, filePath string, fileData string) error
+	DeleteResource(resource Resource) error
+	// IsDeletable returns false for deployed resources that should never be deleted by the tool.
+	IsDeletable(resource Resource) bool
+}
+
+// LocalFileOrderer can be implemented by resource handlers that need some local files to be imported before the others.
+type LocalFileOrderer interface {
+	// IsImportedFirst returns true if the resource in the given file should be imported before the other resources.
+	IsImportedFirst(fileName string) bool
+}
+
+// LocalFileSplitter can be implemented by resource handlers whose local files can define more than one resource.
+type LocalFileSplitter interface {
+	// SplitLocalFile returns the content of each resource defined in a local file.
+	SplitLocalFile(fileData []byte, fileInfo FileInfo) ([][]byte, error)
+}
+
+// AttachmentHandler can be implemented by resource handlers that store parts of a resource, such as the HTML body of an
+// email template, in separate files next to the resource file. An attachment is named with the file name of the
+// resource followed by a suffix starting with a dot (ex: AccountConfirmation_en_US.html).
+type AttachmentHandler interface {
+	// ExtractAttachments returns the exported content without the attachments, and the attachments by file name.
+	ExtractAttachments(resource Resource, fileName string, content []byte) ([]byte, map[string][]byte, error)
+	// IsAttachment returns true if the given local file is an attachment of a resource file.
+	IsAttachment(fileName string) bool
+}
+
+// OptionalExporter can be implemented by resource handlers of resource types that are not exported by default.
+type OptionalExporter interface {
+	// IsExportEnabled returns true if the deployed resources should be exported and compared with the local files.
+	IsExportEnabled() bool
+}
+
+// ReferenceResolver can be implemented by resource handlers whose resources refer to resources of other types, so that
+// the references can be validated before importing the resources.
+type ReferenceResolver interface {
+	// GetReferences returns the names of the resources referred to in a local file, by their resource types.
+	GetReferences(fileData []byte) (map[string][]string, error)
+}
+
+// ReferenceProvider can be implemented by resource handlers whose resources are referred to by names other than the
+// resource names, such as the claims of the local claim dialect.
+type ReferenceProvider interface {
+	// GetLocalReferenceNames returns the names by which the resources in a local file can be referred to.
+	GetLocalReferenceNames(fileData []byte) ([]string, error)
+	// GetDeployedReferenceNames returns the names by which the deployed resources can be referred to.
+	GetDeployedReferenceNames() ([]string, error)
+}
+
+// AttributeProvider is implemented by handlers whose resources can be filtered by their attributes in the INCLUDE_ONLY
+// and EXCLUDE configs.
+type AttributeProvider interface {
+	// GetDeployedAttributes returns the attributes of a deployed resource by their names.
+	GetDeployedAttributes(resource Resource) (map[string][]string, error)
+	// GetLocalAttributes returns the attributes of a resource by their names, given the content of its local file.
+	GetLocalAttributes(fileData []byte) (map[string][]string, error)
+}
+
+var resourceHandlers []ResourceHandler
+
+func RegisterResourceHandler(handler ResourceHandler) {
+
+	resourceHandlers = append(resourceHandlers, handler)
+}
+
+func GetResourceHandler(resourceType string) ResourceHandler {
+
+	for _, handler := range resourceHandlers {
+		if handler.GetResourceType() == resourceType {
+			return handler
+		}
+	}
+	return nil
+}
+
+// FindResourceHandler returns the handler of the given resource type, ignoring the case of the resource type.
+func FindResourceHandler(resourceType string) (ResourceHandler, error) {
+
+	var supportedTypes []string
+	for _, handler := range GetResourceHandlers() {
+		if strings.EqualFold(handler.GetResourceType(), resourceType) {
+			return handler, nil
+		}
+		supportedTypes = append(supportedTypes, handler.GetResourceType())
+	}
+	return nil, fmt.Errorf("unsupported resource type: %s. Supported resource types: %s", resourceType,
+		strings.Join(supportedTypes, ", "))
+}
+
+// GetResourceHandlers returns the registered handlers ordered so that each resource type comes after its dependencies.
+func GetResourceHandlers() []ResourceHandler {
+
+	handlers := make([]ResourceHandler, len(resourceHandlers))
+	copy(handlers, resourceHandlers)
+	sort.Slice(handlers, func(i, j int) bool {
+		return handlers[i].GetResourceType() < handlers[j].GetResourceType()
+	})
+
+	var orderedHandlers []ResourceHandler
+	visited := make(map[string]bool)
+	inProgress := make(map[string]bool)
+
+	var visit func(handler ResourceHandler)
+	visit = func(handler ResourceHandler) {
+		resourceType := handler.GetResourceType()
+		if visited[resourceType] {
+			return
+		}
+		if inProgress[resourceType] {
+			log.Println("Warning: Circular dependency detected for resource type: " + resourceType)
+			return
+		}
+		inProgress[resourceType] = true
+		for _, dependency := range handler.GetDependencies() {
+			if dependencyHandler := GetResourceHandler(dependency); dependencyHandler != nil {
+				visit(dependencyHandler)
+			}
+		}
+		inProgress[resourceType] = false
+		visited[resourceType] = true
+		orderedHandlers = append(orderedHandlers, handler)
+	}
+
+	for _, handler := range handlers {
+		visit(handler)
+	}
+	return orderedHandlers
+}
+
+func GetResourceToolConfigs(handler ResourceHandler) map[string]interface{} {
+
+	return TOOL_CONFIGS.ResourceConfigs[handler.GetConfigKey()]
+}
+
+func GetResourceKeywordMapping(handler ResourceHandler, resourceName string) map[string]interface{} {
+
+	if resourceConfigs, ok := KEYWORD_CONFIGS.ResourceConfigs[handler.GetConfigKey()]; ok {
+		return ResolveAdvancedKeywordMapping(resourceName, resourceConfigs)
+	}
+	return KEYWORD_CONFIGS.KeywordMappings
+}
+
+func isExportEnabled(handler ResourceHandler) bool {
+
+	if exporter, ok := handler.(OptionalExporter); ok {
+		return exporter.IsExportEnabled()
+	}
+	return true
+}
+
+func getDeployedResourceNames(handler ResourceHandler, resources []Resource) []string {
+
+	var names []string
+	for _, resource := range resources {
+		names = append(names, handler.GetFileName(resource))
+	}
+	return names
+}
@@ -28,11 +28,19 @@ import (
 
 func IsResourceExcluded(resourceName string, resourceConfigs map[string]interface{}) bool {
 
+	return isResourceExcluded(resourceName, nil, resourceConfigs)
+}
+
+// isResourceExcluded returns true if the resource is excluded by the INCLUDE_ONLY or EXCLUDE configs. The attributes
+// of the resource are only retrieved if an attribute pattern is used in the configs.
+func isResourceExcluded(resourceName string, getAttributes func() map[string][]string,
+	resourceConfigs map[string]interface{}) bool {
+
 	// Include only the resources added to INCLUDE_ONLY config. Note: INCLUDE_ONLY config overrides the EXCLUDE config.
 	includeOnlyResources, ok := resourceConfigs[INCLUDE_ONLY_CONFIG].([]interface{})
 	if ok {
 		for _, resource := range includeOnlyResources {
-			if resource.(string) == resourceName {
+			if MatchesResourcePattern(resource.(string), resourceName, getAttributes) {
 				return false
 			}
 		}
@@ -43,7 +51,7 @@ func IsResourceExcluded(resourceName string, resourceConfigs map[string]interfac
 		resourcesToExclude, ok := resourceConfigs[EXCLUDE_CONFIG].([]interface{})
 		if ok {
 			for _, resource := range resourcesToExclude {
-				if resource.(string) == resourceName {
+				if MatchesResourcePattern(resource.(string), resourceName, getAttributes) {
 					log.Println("Excluded resource: " + resourceName)
 					return true
 				}
@@ -55,22 +63,34 @@ func IsResourceExcluded(resourceName string, resourceConfigs map[string]interfac
 
 func IsResourceTypeExcluded(resourceType string) bool {
 
+	if isResourceTypeExcluded(resourceType) {
+		log.Println("Skipping Excluded resource: " + resourceType)
+		return true
+	}
+	return false
+}
+
+// IsResourceTypeIncluded reports whether the resource type is processed by the tool, without logging the exclusion.
+func IsResourceTypeIncluded(resourceType string) bool {
+
+	return !isResourceTypeExcluded(resourceType)
+}
+
+func isResourceTypeExcluded(resourceType string) bool {
+
 	// Include only the resource types added to INCLUDE_ONLY config. Note: INCLUDE_ONLY config overrides the EXCLUDE config.
 	if len(TOOL_CONFIGS.IncludeOnly) > 0 {
 		for _, resource := range TOOL_CONFIGS.IncludeOnly {
-			if resource == resourceType {
+			if MatchesResourcePattern(resource, resourceType, nil) {
 				return false
 			}
 		}
-		log.Println("Skipping Excluded resource: " + resourceType)
 		return true
-	} else if len(TOOL_CONFIGS.Exclude) > 0 {
-		// Exclude resource types added to EXCLUDE config.
-		for _, resource := range TOOL_CONFIGS.Exclude {
-			if resource == resourceType {
-				log.Println("Skipping Excluded resource: " + resourceType)
-				return true
-			}
+	}
+	// Exclude resource types added to EXCLUDE config.
+	for _, resource := range TOOL_CONFIGS.Exclude {
+		if MatchesResourcePattern(resource, resourceType, nil) {
+			return true
 		}
 	}
 	return false
@@ -110,9 +130,9 @@ func AreSecretsExcluded(resourceConfigs map[string]interface{}) bool {
 	return TOOL_CONFIGS.ExcludeSecrets
 }
 
-func RemoveDeletedLocalResources(filePath string, deployedResourceNames []string) {
+func RemoveDeletedLocalResources(handler ResourceHandler, filePath string, deployedResourceNames []string) {
 
-	// Remove local files of resources that do not exist in the remote during export.
+	// Remove local files of resources that do not exist in the remote during export, along with their attachments.
 	files, err := ioutil.ReadDir(filePath)
 	if err != nil {
 		log.Println("Error loading local files: ", err)
@@ -121,7 +141,8 @@ func RemoveDeletedLocalResources(filePath string, deployedResourceNames []string
 
 	for _, file := range files {
 		fileName := file.Name()
-		if !Contains(deployedResourceNames, GetFileInfo(fileName).ResourceName) {
+		if !Contains(deployedResourceNames, GetFileInfo(fileName).ResourceName) &&
+			!isAttachmentOfResources(handler, fileName, deployedResourceNames) {
 			err := os.Remove(filepath.Join(filePath, fileName))
 			if err != nil {
 				log.Println("Error when removing the file: ", fileName, err)
@@ -0,0 +1,185 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const DEFAULT_MAX_RETRY_ATTEMPTS = 3
+const DEFAULT_REQUEST_TIMEOUT = 60
+const DEFAULT_MAX_RETRY_BACKOFF = 30
+const INITIAL_RETRY_BACKOFF = 500 * time.Millisecond
+
+// Status codes of transient failures that are safe to retry for idempotent requests.
+var retryableStatusCodes = map[int]bool{
+	429: true,
+	502: true,
+	503: true,
+	504: true,
+}
+
+// sendRequest sends the given request with the shared client and retries transient failures with exponential backoff.
+// Non-idempotent requests are retried only when the server has not processed the request, to avoid creating duplicates.
+func sendRequest(request *http.Request, resourceType string) (*http.Response, error) {
+
+	idempotent := request.Method != http.MethodPost
+	maxAttempts := getMaxRetryAttempts()
+
+	reauthenticated := false
+	for attempt := 1; ; attempt++ {
+		ensureValidToken(request)
+		client, err := GetHttpClient()
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.Do(request)
+
+		// Re-authenticate once if the access token is rejected before giving up on the request.
+		if err == nil && resp.StatusCode == 401 && isBearerTokenRequest(request) && !reauthenticated {
+			reauthenticated = true
+			usedToken := strings.TrimPrefix(request.Header.Get("Authorization"), "Bearer ")
+			if reauthenticate(usedToken) && rewindRequestBody(request) {
+				io.Copy(ioutil.Discard, resp.Body)
+				resp.Body.Close()
+				attempt--
+				continue
+			}
+		}
+		if attempt >= maxAttempts || !isRetryable(resp, err, idempotent) || !rewindRequestBody(request) {
+			return resp, err
+		}
+
+		delay := GetRetryDelay(attempt, resp)
+		if err != nil {
+			log.Printf("Request to %s failed: %s. Retrying in %s (attempt %d of %d).", request.URL.Path, err, delay, attempt+1, maxAttempts)
+		} else {
+			log.Printf("Request to %s failed with status: %s. Retrying in %s (attempt %d of %d).", request.URL.Path, resp.Status, delay, attempt+1, maxAttempts)
+			// Drain the response body to reuse the connection for the next attempt.
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		UpdateRetrySummary(resourceType)
+		time.Sleep(delay)
+	}
+}
+
+// GetRetryDelay returns the delay before the next attempt. The Retry-After header is honoured when present, up to the
+// max retry backoff, otherwise an exponential backoff with full jitter is used.
+func GetRetryDelay(attempt int, resp *http.Response) time.Duration {
+
+	maxBackoff := time.Duration(getMaxRetryBackoff()) * time.Second
+	if resp != nil {
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if delay > maxBackoff {
+				return maxBackoff
+			}
+			return delay
+		}
+	}
+
+	backoff := INITIAL_RETRY_BACKOFF << uint(attempt-1)
+	if backoff <= 0 || backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
+
+func isRetryable(resp *http.Response, err error, idempotent bool) bool {
+
+	if err != nil {
+		if idempotent {
+			return true
+		}
+		// A failed connection attempt guarantees that the request has not reached the server.
+		var opErr *net.OpError
+		return errors.As(err, &opErr) && opErr.Op == "dial"
+	}
+	if !idempotent {
+		// The server rejects rate limited requests without processing them.
+		return resp.StatusCode == 429
+	}
+	return retryableStatusCodes[resp.StatusCode]
+}
+
+func rewindRequestBody(request *http.Request) bool {
+
+	if request.Body == nil || request.GetBody == nil {
+		return request.Body == nil
+	}
+	body, err := request.GetBody()
+	if err != nil {
+		return false
+	}
+	request.Body = body
+	return true
+}
+
+func parseRetryAfter(retryAfter string) (time.Duration, bool) {
+
+	if retryAfter == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if retryTime, err := http.ParseTime(retryAfter); err == nil {
+		delay := time.Until(retryTime)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+func getMaxRetryAttempts() int {
+
+	if TOOL_CONFIGS.MaxRetryAttempts > 0 {
+		return TOOL_CONFIGS.MaxRetryAttempts
+	}
+	return DEFAULT_MAX_RETRY_ATTEMPTS
+}
+
+func getMaxRetryBackoff() int {
+
+	if TOOL_CONFIGS.MaxRetryBackoff > 0 {
+		return TOOL_CONFIGS.MaxRetryBackoff
+	}
+	return DEFAULT_MAX_RETRY_BACKOFF
+}
+
+func applyRequestTimeout() {
+
+	timeout := DEFAULT_REQUEST_TIMEOUT
+	if TOOL_CONFIGS.RequestTimeout > 0 {
+		timeout = TOOL_CONFIGS.RequestTimeout
+	}
+	httpClient.Timeout = time.Duration(timeout) * time.Second
+	log.Printf("Request timeout set to %d seconds.", timeout)
+}
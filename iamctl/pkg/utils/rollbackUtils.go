@@ -0,0 +1,248 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const ROLLBACK = "rollback"
+const SNAPSHOT = "Snapshot"
+const SNAPSHOT_FORMAT = "yaml"
+
+type journalEntry struct {
+	Handler   ResourceHandler
+	Resource  Resource
+	Operation string
+}
+
+// importSnapshot contains the deployed resources exported before an import, so that the changes can be reverted.
+type importSnapshot struct {
+	dirPath string
+	// Exported files of the resources by the resource type and the resource name.
+	files   map[string]map[string]string
+	journal []journalEntry
+	mutex   sync.Mutex
+}
+
+var (
+	// Revert the changes of an import if any of the operations fail.
+	ROLLBACK_ON_FAILURE bool
+	// Directory to store the snapshots taken before importing. Defaults to the current working directory.
+	SNAPSHOT_DIR   string
+	activeSnapshot *importSnapshot
+)
+
+// createSnapshot exports the deployed resources of the given handlers that are imported from the input directory into
+// a timestamped snapshot folder, before any change is made in the target environment. Resources that cannot be exported
+// are left out of the snapshot with a warning, and the changes made to them cannot be reverted.
+func createSnapshot(handlers []ResourceHandler, inputDirPath string) (*importSnapshot, error) {
+
+	dirPath := filepath.Join(SNAPSHOT_DIR, "iamctl-snapshot-"+runStartTime.Format("20060102-150405"), currentTenant)
+	if IsOrganizationContext() {
+		dirPath = filepath.Join(dirPath, ORGANIZATIONS, SERVER_CONFIGS.OrganizationId)
+	}
+	if err := os.MkdirAll(dirPath, 0700); err != nil {
+		return nil, fmt.Errorf("error when creating the snapshot folder: %s", err)
+	}
+	snapshot := &importSnapshot{dirPath: dirPath, files: make(map[string]map[string]string)}
+	log.Printf("Creating a snapshot of the deployed resources at %s", dirPath)
+
+	for _, handler := range handlers {
+		resourceType := handler.GetResourceType()
+		if isResourceTypeExcluded(resourceType) || !isImported(handler, inputDirPath) {
+			continue
+		}
+		if !isExportEnabled(handler) {
+			log.Printf("Warning: %s are not added to the snapshot since their export is not enabled. "+
+				"The changes made to them cannot be reverted.", resourceType)
+			continue
+		}
+		if err := snapshot.addResources(handler); err != nil {
+			log.Printf("Warning: %s are not added to the snapshot. The changes made to them cannot be reverted. %s",
+				resourceType, err)
+		}
+	}
+	return snapshot, nil
+}
+
+// isImported returns true if the deployed resources of the handler can be changed by importing the input directory.
+func isImported(handler ResourceHandler, inputDirPath string) bool {
+
+	if TOOL_CONFIGS.AllowDelete {
+		// Deployed resources are deleted even if the resource type folder does not exist.
+		return true
+	}
+	_, err := os.Stat(filepath.Join(inputDirPath, handler.GetResourceType()))
+	return err == nil
+}
+
+// addResources exports the deployed resources of the handler to the snapshot folder.
+func (s *importSnapshot) addResources(handler ResourceHandler) error {
+
+	resourceType := handler.GetResourceType()
+	inventory, err := GetInventory(handler)
+	if err != nil {
+		return fmt.Errorf("error when retrieving deployed %s: %s", resourceType, err)
+	}
+	snapshotDirPath := filepath.Join(s.dirPath, resourceType)
+	if err := os.MkdirAll(snapshotDirPath, 0700); err != nil {
+		return fmt.Errorf("error when creating the snapshot folder: %s", err)
+	}
+
+	resources := inventory.List()
+	resourceConfigs := GetResourceToolConfigs(handler)
+	files := make(map[string]string)
+	var mutex sync.Mutex
+	RunInParallel(len(resources), func(i int) {
+		resource := resources[i]
+		if IsDeployedResourceExcluded(handler, resource, resourceConfigs) {
+			return
+		}
+		filePath, err := exportSnapshotResource(handler, resource, snapshotDirPath)
+		if err != nil {
+			log.Printf("Warning: %s: %s is not added to the snapshot. The changes made to it cannot be reverted. %s",
+				resourceType, resource.Name, err)
+			return
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		files[resource.Name] = filePath
+	})
+	s.files[resourceType] = files
+	return nil
+}
+
+func exportSnapshotResource(handler ResourceHandler, resource Resource, snapshotDirPath string) (string, error) {
+
+	// Secrets are included where the server returns them, so that the resources can be restored as they were.
+	fileName, content, attachments, err := GetExportedContent(handler, resource, snapshotDirPath, SNAPSHOT_FORMAT, false)
+	if err != nil {
+		return "", err
+	}
+	if err := ioutil.WriteFile(fileName, content, 0600); err != nil {
+		return "", fmt.Errorf("error when writing the snapshot to file: %w", err)
+	}
+	for attachmentPath, attachment := range attachments {
+		if err := ioutil.WriteFile(attachmentPath, attachment, 0600); err != nil {
+			return "", fmt.Errorf("error when writing the snapshot to file: %w", err)
+		}
+	}
+	return fileName, nil
+}
+
+// recordChange records a change made in the target environment, so that it can be reverted if the import fails.
+func recordChange(handler ResourceHandler, resource Resource, operation string) {
+
+	if activeSnapshot == nil {
+		return
+	}
+	activeSnapshot.mutex.Lock()
+	defer activeSnapshot.mutex.Unlock()
+
+	activeSnapshot.journal = append(activeSnapshot.journal, journalEntry{
+		Handler:   handler,
+		Resource:  resource,
+		Operation: operation,
+	})
+}
+
+// rollback reverts the recorded changes in the reverse order: created resources are deleted, updated resources are
+// updated with the snapshot and deleted resources are created again from the snapshot.
+func (s *importSnapshot) rollback() {
+
+	log.Printf("Reverting the changes of the import using the snapshot at %s", s.dirPath)
+	for i := len(s.journal) - 1; i >= 0; i-- {
+		entry := s.journal[i]
+		resourceType := entry.Handler.GetResourceType()
+		startTime := time.Now()
+		err := s.revert(entry.Handler, entry)
+		AddResourceResult(resourceType, entry.Resource.Name, ROLLBACK, err, startTime)
+		if err != nil {
+			log.Printf("Error when reverting the changes of %s: %s. %s", resourceType, entry.Resource.Name, err)
+		} else {
+			log.Printf("Changes reverted successfully in %s: %s", resourceType, entry.Resource.Name)
+		}
+	}
+}
+
+func (s *importSnapshot) revert(handler ResourceHandler, entry journalEntry) error {
+
+	resource := entry.Resource
+	switch entry.Operation {
+	case IMPORT:
+		if resource.Id == "" {
+			// Resolve the ID of the created resource, if it was not returned by the server.
+			InvalidateInventory(handler.GetResourceType())
+			inventory, err := GetInventory(handler)
+			if err != nil {
+				return err
+			}
+			deployedResource, ok := inventory.GetByName(resource.Name)
+			if !ok {
+				return fmt.Errorf("created resource not found in the target environment")
+			}
+			resource = deployedResource
+		}
+		return handler.DeleteResource(resource)
+	case UPDATE:
+		filePath, fileData, err := s.getSnapshotResource(handler, resource.Name)
+		if err != nil {
+			return err
+		}
+		return handler.UpdateResource(resource, filePath, fileData)
+	case DELETE:
+		filePath, fileData, err := s.getSnapshotResource(handler, resource.Name)
+		if err != nil {
+			return err
+		}
+		_, err = handler.ImportResource(Resource{Name: resource.Name}, filePath, fileData)
+		return err
+	}
+	return nil
+}
+
+// getSnapshotResource returns the snapshot file of the resource and the content of the resource in the file.
+func (s *importSnapshot) getSnapshotResource(handler ResourceHandler, resourceName string) (string, string, error) {
+
+	filePath, ok := s.files[handler.GetResourceType()][resourceName]
+	if !ok {
+		return "", "", fmt.Errorf("resource not found in the snapshot")
+	}
+	inventory, err := GetInventory(handler)
+	if err != nil {
+		return "", "", err
+	}
+	localResources, err := ResolveLocalFile(handler, filePath, inventory)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid snapshot file: %s", err)
+	}
+	for _, localResource := range localResources {
+		if localResource.Resource.Name == resourceName {
+			return filePath, localResource.FileData, nil
+		}
+	}
+	return "", "", fmt.Errorf("resource not found in the snapshot file: %s", filePath)
+}
@@ -20,14 +20,15 @@ package utils
 
 import (
 	"bytes"
-	"crypto/tls"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 )
 
@@ -40,30 +41,40 @@ type oAuthResponse struct {
 }
 
 type ServerConfigs struct {
-	ServerUrl    string `json:"SERVER_URL"`
-	ClientId     string `json:"CLIENT_ID"`
-	ClientSecret string `json:"CLIENT_SECRET"`
-	TenantDomain string `json:"TENANT_DOMAIN"`
-	Token        string `json:"TOKEN"`
+	ServerUrl          string                   `json:"SERVER_URL"`
+	ClientId           string                   `json:"CLIENT_ID"`
+	ClientSecret       string                   `json:"CLIENT_SECRET"`
+	TenantDomain       string                   `json:"TENANT_DOMAIN"`
+	Token              string                   `json:"TOKEN"`
+	CaCertPath         string                   `json:"CA_CERT_PATH"`
+	ClientCertPath     string                   `json:"CLIENT_CERT_PATH"`
+	ClientKeyPath      string                   `json:"CLIENT_KEY_PATH"`
+	InsecureSkipVerify bool                     `json:"INSECURE_SKIP_VERIFY"`
+	OrganizationId     string                   `json:"ORGANIZATION_ID"`
+	TenantDomains      []string                 `json:"TENANT_DOMAINS"`
+	DiscoverTenants    bool                     `json:"DISCOVER_TENANTS"`
+	TenantConfigs      map[string]ServerConfigs `json:"TENANTS"`
 }
 
+// ToolConfigs holds the tool configs. The configs of each resource type are loaded into ResourceConfigs by the config key
+// of the resource type.
 type ToolConfigs struct {
-	AllowDelete        bool                   `json:"ALLOW_DELETE"`
-	Exclude            []string               `json:"EXCLUDE"`
-	IncludeOnly        []string               `json:"INCLUDE_ONLY"`
-	ExcludeSecrets     bool                   `json:"EXCLUDE_SECRETS"`
-	ApplicationConfigs map[string]interface{} `json:"APPLICATIONS"`
-	IdpConfigs         map[string]interface{} `json:"IDENTITY_PROVIDERS"`
-	ClaimConfigs       map[string]interface{} `json:"CLAIMS"`
-	UserStoreConfigs   map[string]interface{} `json:"USERSTORES"`
+	AllowDelete      bool     `json:"ALLOW_DELETE"`
+	Exclude          []string `json:"EXCLUDE"`
+	IncludeOnly      []string `json:"INCLUDE_ONLY"`
+	ExcludeSecrets   bool     `json:"EXCLUDE_SECRETS"`
+	MaxRetryAttempts int      `json:"MAX_RETRY_ATTEMPTS"`
+	MaxRetryBackoff  int      `json:"MAX_RETRY_BACKOFF"`
+	RequestTimeout   int      `json:"REQUEST_TIMEOUT"`
+	ResourceConfigs  map[string]map[string]interface{}
 }
 
+// KeywordConfigs holds the keyword configs. The keyword configs of each resource type are loaded into ResourceConfigs
+// by the config key of the resource type.
 type KeywordConfigs struct {
-	KeywordMappings    map[string]interface{} `json:"KEYWORD_MAPPINGS"`
-	ApplicationConfigs map[string]interface{} `json:"APPLICATIONS"`
-	IdpConfigs         map[string]interface{} `json:"IDENTITY_PROVIDERS"`
-	ClaimConfigs       map[string]interface{} `json:"CLAIMS"`
-	UserStoreConfigs   map[string]interface{} `json:"USERSTORES"`
+	KeywordMappings map[string]interface{} `json:"KEYWORD_MAPPINGS"`
+	ResourceConfigs map[string]map[string]interface{}
+	TenantConfigs   map[string]json.RawMessage `json:"TENANTS"`
 }
 
 var SERVER_CONFIGS ServerConfigs
@@ -74,6 +85,7 @@ func LoadConfigs(envConfigPath string) (baseDir string) {
 
 	baseDir, toolConfigFile, keywordConfigPath := loadServerConfigs(envConfigPath)
 	TOOL_CONFIGS = loadToolConfigsFromFile(toolConfigFile)
+	applyRequestTimeout()
 	KEYWORD_CONFIGS = loadKeywordConfigsFromFile(keywordConfigPath)
 	return baseDir
 }
@@ -95,8 +107,16 @@ func loadServerConfigs(envConfigPath string) (baseDir string, toolConfigPath str
 	}
 	sanitizeServerConfigs()
 
+	err := InitHttpClient(SERVER_CONFIGS)
+	if err != nil {
+		ExitWithError(EXIT_CODE_CONFIG_ERROR, "Error when configuring the HTTP client.", err)
+	}
+
 	// Get access token.
-	SERVER_CONFIGS.Token = getAccessToken(SERVER_CONFIGS)
+	err = RefreshAccessToken()
+	if err != nil {
+		ExitWithError(EXIT_CODE_AUTH_ERROR, "Error when getting the access token.", err)
+	}
 	log.Println("Access Token recieved succesfully.")
 	return baseDir, toolConfigPath, keywordConfigPath
 }
@@ -108,6 +128,12 @@ func loadConfigsFromEnvVar() (toolConfigPath string, keywordConfigPath string) {
 	SERVER_CONFIGS.ClientId = os.Getenv(CLIENT_ID_CONFIG)
 	SERVER_CONFIGS.ClientSecret = os.Getenv(CLIENT_SECRET_CONFIG)
 	SERVER_CONFIGS.TenantDomain = os.Getenv(TENANT_DOMAIN_CONFIG)
+	SERVER_CONFIGS.OrganizationId = os.Getenv(ORGANIZATION_ID_CONFIG)
+	if tenantDomains := os.Getenv(TENANT_DOMAINS_CONFIG); tenantDomains != "" {
+		SERVER_CONFIGS.TenantDomains = strings.Split(tenantDomains, ",")
+	}
+	SERVER_CONFIGS.DiscoverTenants, _ = strconv.ParseBool(os.Getenv(DISCOVER_TENANTS_CONFIG))
+	loadTLSConfigsFromEnvVar()
 
 	// Load tool config file path from environment variables.
 	toolConfigPath = os.Getenv(TOOL_CONFIG_PATH)
@@ -119,7 +145,7 @@ func loadServerConfigsFromFile(configFilePath string) (serverConfigs ServerConfi
 
 	configFile, err := ioutil.ReadFile(configFilePath)
 	if err != nil {
-		log.Fatalln(err.Error())
+		ExitWithError(EXIT_CODE_CONFIG_ERROR, err.Error())
 	}
 
 	// Replace placeholder keys with environment variable values
@@ -129,7 +155,7 @@ func loadServerConfigsFromFile(configFilePath string) (serverConfigs ServerConfi
 	jsonParser := json.NewDecoder(reader)
 	err = jsonParser.Decode(&serverConfigs)
 	if err != nil {
-		log.Fatalln(err)
+		ExitWithError(EXIT_CODE_CONFIG_ERROR, "Server configs are not in the correct format. Please check the config file.", err)
 	}
 	log.Println("Server configs loaded succesfully from the config file.")
 	return serverConfigs
@@ -139,7 +165,7 @@ func loadToolConfigsFromFile(configFilePath string) (toolConfigs ToolConfigs) {
 
 	configFile, err := ioutil.ReadFile(configFilePath)
 	if err != nil {
-		log.Fatalln("Error when reading the tool config file.", err.Error())
+		ExitWithError(EXIT_CODE_CONFIG_ERROR, "Error when reading the tool config file.", err.Error())
 	}
 
 	if len(configFile) == 0 {
@@ -149,8 +175,9 @@ func loadToolConfigsFromFile(configFilePath string) (toolConfigs ToolConfigs) {
 	TOOL_CONFIGS.ExcludeSecrets = true
 	err = json.Unmarshal(configFile, &toolConfigs)
 	if err != nil {
-		log.Fatalln("Tool configs are not in the correct format. Please check the config file.", err)
+		ExitWithError(EXIT_CODE_CONFIG_ERROR, "Tool configs are not in the correct format. Please check the config file.", err)
 	}
+	toolConfigs.ResourceConfigs = loadResourceConfigs(configFile)
 
 	log.Println("Tool configs loaded successfully from the config file.")
 	return toolConfigs
@@ -160,7 +187,7 @@ func loadKeywordConfigsFromFile(configFilePath string) (keywordConfigs KeywordCo
 
 	configFile, err := ioutil.ReadFile(configFilePath)
 	if err != nil {
-		log.Fatalln("Error when reading the keyword config file.", err.Error())
+		ExitWithError(EXIT_CODE_CONFIG_ERROR, "Error when reading the keyword config file.", err.Error())
 	}
 
 	if len(configFile) == 0 {
@@ -170,65 +197,108 @@ func loadKeywordConfigsFromFile(configFilePath string) (keywordConfigs KeywordCo
 	// Replace placeholder keys with environment variable values
 	configFile = ReplacePlaceholders(configFile)
 
-	err = json.Unmarshal(configFile, &keywordConfigs)
+	keywordConfigs, err = parseKeywordConfigs(configFile)
 	if err != nil {
-		log.Fatalln("Keyword configs are not in the correct format. Please check the config file.", err)
+		ExitWithError(EXIT_CODE_CONFIG_ERROR, "Keyword configs are not in the correct format. Please check the config file.", err)
 	}
 
 	log.Println("Keyword configs loaded successfully from the config file.")
 	return keywordConfigs
 }
 
-func getAccessToken(config ServerConfigs) string {
+func parseKeywordConfigs(configFile []byte) (keywordConfigs KeywordConfigs, err error) {
 
-	var err error
-	var response oAuthResponse
+	if err = json.Unmarshal(configFile, &keywordConfigs); err != nil {
+		return keywordConfigs, err
+	}
+	keywordConfigs.ResourceConfigs = loadResourceConfigs(configFile)
+	return keywordConfigs, nil
+}
+
+func loadResourceConfigs(configFile []byte) map[string]map[string]interface{} {
+
+	// Collect the configs added under each resource type, so that they can be resolved by the resource config key.
+	var configs map[string]interface{}
+	resourceConfigs := make(map[string]map[string]interface{})
+	if err := json.Unmarshal(configFile, &configs); err != nil {
+		return resourceConfigs
+	}
+	for key, value := range configs {
+		if resourceConfig, ok := value.(map[string]interface{}); ok && key != KEYWORD_MAPPINGS_CONFIG && key != TENANTS_CONFIG {
+			resourceConfigs[key] = resourceConfig
+		}
+	}
+	return resourceConfigs
+}
+
+func getAccessToken(config ServerConfigs) (oAuthResponse, error) {
 
 	if config.ServerUrl == "" {
-		log.Fatalln("Server URL is not defined in the config file.")
+		ExitWithError(EXIT_CODE_CONFIG_ERROR, "Server URL is not defined in the config file.")
 	}
-	authUrl := config.ServerUrl + "/t/" + config.TenantDomain + "/oauth2/token"
 
 	body := url.Values{}
 	body.Set("grant_type", "client_credentials")
 	body.Set("scope", SCOPE)
+	return requestAccessToken(config, body)
+}
+
+// getOrganizationAccessToken exchanges the access token of the root organization for an access token of the
+// organization configured in the server configs, using the organization switch grant.
+func getOrganizationAccessToken(config ServerConfigs, rootToken string) (oAuthResponse, error) {
+
+	body := url.Values{}
+	body.Set("grant_type", "organization_switch")
+	body.Set("token", rootToken)
+	body.Set("switching_organization", config.OrganizationId)
+	body.Set("scope", getOrganizationScopes())
+	return requestAccessToken(config, body)
+}
+
+// getOrganizationScopes returns the scopes of the organization APIs corresponding to the scopes requested by the tool.
+func getOrganizationScopes() string {
+
+	var scopes []string
+	for _, scope := range strings.Fields(SCOPE) {
+		scopes = append(scopes, strings.Replace(scope, "internal_", "internal_org_", 1))
+	}
+	return strings.Join(scopes, " ")
+}
+
+func requestAccessToken(config ServerConfigs, body url.Values) (oAuthResponse, error) {
+
+	var err error
+	var response oAuthResponse
+	authUrl := config.ServerUrl + "/t/" + config.TenantDomain + "/oauth2/token"
 
 	req, err := http.NewRequest("POST", authUrl, strings.NewReader(body.Encode()))
 	if err != nil {
-		log.Fatalln(err)
+		return response, err
 	}
 	req.SetBasicAuth(config.ClientId, config.ClientSecret)
 	req.Header.Set("Content-Type", MEDIA_TYPE_FORM)
 	defer req.Body.Close()
 
-	httpClient := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
-	}
-	resp, err := httpClient.Do(req)
+	resp, err := sendRequest(req, "")
 	if err != nil {
-		log.Fatalln(err)
+		return response, err
 	}
 	defer resp.Body.Close()
 
 	respBody, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		log.Fatalln(err)
+		return response, err
 	}
 
 	if resp.StatusCode != 200 {
-		log.Fatalln("Error in getting access token, response: " + string(respBody))
+		return response, fmt.Errorf("error in getting access token, response: %s", string(respBody))
 	}
 
-	err2 := json.Unmarshal(respBody, &response)
-	if err2 != nil {
-		log.Fatalln(err2)
+	err = json.Unmarshal(respBody, &response)
+	if err != nil {
+		return response, err
 	}
-
-	return response.AccessToken
+	return response, nil
 }
 
 func sanitizeServerConfigs() {
@@ -236,6 +306,9 @@ func sanitizeServerConfigs() {
 	SERVER_CONFIGS.ServerUrl = strings.TrimSuffix(SERVER_CONFIGS.ServerUrl, "/")
 
 	// Set tenant domain if not defined in the config file.
+	if SERVER_CONFIGS.TenantDomain == "" && len(SERVER_CONFIGS.TenantDomains) > 0 {
+		SERVER_CONFIGS.TenantDomain = SERVER_CONFIGS.TenantDomains[0]
+	}
 	if SERVER_CONFIGS.TenantDomain == "" {
 		log.Println("Tenant domain not defined. Defaulting to: carbon.super")
 		SERVER_CONFIGS.TenantDomain = DEFAULT_TENANT_DOMAIN
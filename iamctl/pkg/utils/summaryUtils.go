@@ -20,21 +20,26 @@ package utils
 
 import (
 	"fmt"
+	"sort"
+	"sync"
 )
 
 type Summary struct {
-	SuccessfulOperations int
-	FailedOperations     int
-	TotalRequests        int
+	SuccessfulOperations int `json:"successfulOperations"`
+	FailedOperations     int `json:"failedOperations"`
+	TotalRequests        int `json:"totalRequests"`
+	RetriedRequests      int `json:"retriedRequests"`
 }
 
 type ResourceSummary struct {
 	ResourceType                string
+	Tenant                      string
 	SuccessfulExport            int
 	SuccessfulImport            int
 	SuccessfulUpdate            int
 	Failed                      int
 	Deleted                     int
+	Retries                     int
 	SecretGeneratedApplications []string
 	FailedResources             []string
 }
@@ -42,6 +47,8 @@ type ResourceSummary struct {
 var (
 	SummaryData       Summary
 	ResourceSummaries map[string]ResourceSummary
+	// Guards the summary data, since resources are processed concurrently.
+	summaryMutex sync.Mutex
 )
 
 func PrintSummary(Operation string) {
@@ -54,6 +61,7 @@ func PrintSummary(Operation string) {
 	fmt.Printf("Total Requests: %d\n", SummaryData.TotalRequests)
 	fmt.Printf("Successful Operations: %d\n", SummaryData.SuccessfulOperations)
 	fmt.Printf("Failed Operations: %d\n", SummaryData.FailedOperations)
+	fmt.Printf("Retried Requests: %d\n", SummaryData.RetriedRequests)
 
 	if Operation == IMPORT {
 		PrintImportSummary()
@@ -64,11 +72,13 @@ func PrintSummary(Operation string) {
 
 func PrintExportSummary() {
 
-	for _, summary := range ResourceSummaries {
-		fmt.Println("----------------------------------------")
-		fmt.Printf("%s\n", summary.ResourceType)
+	for _, summary := range getSortedSummaries() {
+		printSummaryHeader(summary)
 		fmt.Println("----------------------------------------")
 		fmt.Printf("Successful Exports: %d\n", summary.SuccessfulExport)
+		if summary.Retries > 0 {
+			fmt.Printf("Retries: %d\n", summary.Retries)
+		}
 
 		if summary.Failed > 0 {
 			PrintFailedResources(summary)
@@ -79,13 +89,15 @@ func PrintExportSummary() {
 
 func PrintImportSummary() {
 
-	for _, summary := range ResourceSummaries {
-		fmt.Println("----------------------------------------")
-		fmt.Printf("%s\n", summary.ResourceType)
+	for _, summary := range getSortedSummaries() {
+		printSummaryHeader(summary)
 		fmt.Println("----------------------------------------")
 		fmt.Printf("Successful Imports: %d\n", summary.SuccessfulImport)
 		fmt.Printf("Successful Updates: %d\n", summary.SuccessfulUpdate)
 		fmt.Printf("Deleted: %d\n", summary.Deleted)
+		if summary.Retries > 0 {
+			fmt.Printf("Retries: %d\n", summary.Retries)
+		}
 		if summary.Failed > 0 {
 			PrintFailedResources(summary)
 		}
@@ -96,6 +108,32 @@ func PrintImportSummary() {
 	fmt.Println("----------------------------------------")
 }
 
+// getSortedSummaries returns the resource summaries grouped by the tenant and sorted by the resource type.
+func getSortedSummaries() []ResourceSummary {
+
+	var summaries []ResourceSummary
+	for _, summary := range ResourceSummaries {
+		summaries = append(summaries, summary)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Tenant != summaries[j].Tenant {
+			return summaries[i].Tenant < summaries[j].Tenant
+		}
+		return summaries[i].ResourceType < summaries[j].ResourceType
+	})
+	return summaries
+}
+
+func printSummaryHeader(summary ResourceSummary) {
+
+	fmt.Println("----------------------------------------")
+	if summary.Tenant != "" {
+		fmt.Printf("%s (Tenant: %s)\n", summary.ResourceType, summary.Tenant)
+	} else {
+		fmt.Printf("%s\n", summary.ResourceType)
+	}
+}
+
 func PrintFailedResources(summary ResourceSummary) {
 
 	fmt.Println("....................")
@@ -130,31 +168,27 @@ func printNewSecretApplications(summary ResourceSummary) {
 
 func AddNewSecretIndicatorToSummary(appName string) {
 
+	summaryMutex.Lock()
+	defer summaryMutex.Unlock()
+
 	InitializeResourceSummary()
 
-	summary, ok := ResourceSummaries[APPLICATIONS]
-	if !ok {
-		summary = ResourceSummary{
-			ResourceType: APPLICATIONS,
-		}
-	}
+	summary := getResourceSummary(APPLICATIONS)
 	summary.SecretGeneratedApplications = append(summary.SecretGeneratedApplications, appName)
-	ResourceSummaries[APPLICATIONS] = summary
+	ResourceSummaries[getSummaryKey(APPLICATIONS)] = summary
 }
 
 func UpdateSuccessSummary(resourceType string, operation string) {
 
+	summaryMutex.Lock()
+	defer summaryMutex.Unlock()
+
 	InitializeResourceSummary()
 
 	SummaryData.TotalRequests++
 	SummaryData.SuccessfulOperations++
 
-	summary, ok := ResourceSummaries[resourceType]
-	if !ok {
-		summary = ResourceSummary{
-			ResourceType: resourceType,
-		}
-	}
+	summary := getResourceSummary(resourceType)
 	switch operation {
 	case EXPORT:
 		summary.SuccessfulExport++
@@ -165,25 +199,64 @@ func UpdateSuccessSummary(resourceType string, operation string) {
 	case DELETE:
 		summary.Deleted++
 	}
-	ResourceSummaries[resourceType] = summary
+	ResourceSummaries[getSummaryKey(resourceType)] = summary
 }
 
 func UpdateFailureSummary(resourceType string, resourceName string) {
 
+	summaryMutex.Lock()
+	defer summaryMutex.Unlock()
+
 	InitializeResourceSummary()
 
 	SummaryData.TotalRequests++
 	SummaryData.FailedOperations++
 
-	summary, ok := ResourceSummaries[resourceType]
+	summary := getResourceSummary(resourceType)
+	summary.Failed++
+	summary.FailedResources = append(summary.FailedResources, resourceName)
+	ResourceSummaries[getSummaryKey(resourceType)] = summary
+
+	abortRunIfFailFast()
+}
+
+func UpdateRetrySummary(resourceType string) {
+
+	summaryMutex.Lock()
+	defer summaryMutex.Unlock()
+
+	InitializeResourceSummary()
+
+	SummaryData.RetriedRequests++
+	if resourceType == "" {
+		return
+	}
+
+	summary := getResourceSummary(resourceType)
+	summary.Retries++
+	ResourceSummaries[getSummaryKey(resourceType)] = summary
+}
+
+// getSummaryKey returns the key of the summary of the given resource type, which is separate for each tenant when
+// multiple tenants are processed in a single run.
+func getSummaryKey(resourceType string) string {
+
+	if currentTenant == "" {
+		return resourceType
+	}
+	return currentTenant + "/" + resourceType
+}
+
+func getResourceSummary(resourceType string) ResourceSummary {
+
+	summary, ok := ResourceSummaries[getSummaryKey(resourceType)]
 	if !ok {
 		summary = ResourceSummary{
 			ResourceType: resourceType,
+			Tenant:       currentTenant,
 		}
 	}
-	summary.Failed++
-	summary.FailedResources = append(summary.FailedResources, resourceName)
-	ResourceSummaries[resourceType] = summary
+	return summary
 }
 
 func InitializeResourceSummary() {
@@ -0,0 +1,180 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+var templateFileNameRegex = regexp.MustCompile(`[^\w\d-]+`)
+
+type TemplateType struct {
+	Id          string `json:"id"`
+	DisplayName string `json:"displayName"`
+}
+
+// TemplateTypeManager handles the template types of a notification template resource type, such as email and SMS
+// templates. A template is identified by its template type and locale.
+type TemplateTypeManager struct {
+	path         string
+	resourceType string
+	// Serializes the creation of template types, since the locales of a new template type can be imported concurrently.
+	mutex sync.Mutex
+}
+
+// NewTemplateTypeManager returns the manager of the template types served under the given path of the server base URL.
+func NewTemplateTypeManager(path string, resourceType string) *TemplateTypeManager {
+
+	return &TemplateTypeManager{path: path, resourceType: resourceType}
+}
+
+func (manager *TemplateTypeManager) GetTemplateTypeUrl(templateTypeId string) string {
+
+	if templateTypeId == "" {
+		return GetServerBaseUrl() + manager.path
+	}
+	return GetServerBaseUrl() + manager.path + "/" + templateTypeId
+}
+
+// GetTemplateTypes returns the deployed template types, or an empty list if the template types are not supported by
+// the target environment.
+func (manager *TemplateTypeManager) GetTemplateTypes() ([]TemplateType, error) {
+
+	body, statusCode, err := SendJsonRequest(http.MethodGet, manager.GetTemplateTypeUrl(""), nil, manager.resourceType)
+	if statusCode == http.StatusNotFound {
+		log.Printf("%s are not supported by the target environment.", manager.resourceType)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve the template types of %s. %w", manager.resourceType, err)
+	}
+	var templateTypes []TemplateType
+	if err := json.Unmarshal(body, &templateTypes); err != nil {
+		return nil, fmt.Errorf("error when unmarshalling the retrieved template types of %s. %w", manager.resourceType, err)
+	}
+	return templateTypes, nil
+}
+
+// GetDeployedTemplates returns the deployed templates of all template types, using the given function to retrieve the
+// locales of a template type.
+func (manager *TemplateTypeManager) GetDeployedTemplates(
+	getLocales func(templateTypeId string) ([]string, error)) ([]Resource, error) {
+
+	templateTypes, err := manager.GetTemplateTypes()
+	if err != nil {
+		return nil, err
+	}
+	var resources []Resource
+	for _, templateType := range templateTypes {
+		locales, err := getLocales(templateType.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error when retrieving the templates of: %s. %s", templateType.DisplayName, err)
+		}
+		for _, locale := range locales {
+			resources = append(resources, Resource{
+				Id:   GetTemplateResourceId(templateType.Id, locale),
+				Name: GetTemplateResourceName(templateType.DisplayName, locale),
+			})
+		}
+	}
+	return resources, nil
+}
+
+// GetTemplateTypeId returns the ID of the template type with the given name, or an empty ID if it is not deployed.
+// The ID is resolved from the deployed templates in the inventory, and the template types are retrieved from the
+// server only if the template type has no deployed templates.
+func (manager *TemplateTypeManager) GetTemplateTypeId(templateTypeName string, inventory *ResourceInventory) (string, error) {
+
+	for _, template := range inventory.List() {
+		templateTypeId, locale := SplitTemplateResourceId(template.Id)
+		if strings.EqualFold(template.Name, GetTemplateResourceName(templateTypeName, locale)) {
+			return templateTypeId, nil
+		}
+	}
+	templateTypes, err := manager.GetTemplateTypes()
+	if err != nil {
+		return "", err
+	}
+	for _, templateType := range templateTypes {
+		if strings.EqualFold(templateType.DisplayName, templateTypeName) {
+			return templateType.Id, nil
+		}
+	}
+	return "", nil
+}
+
+// ResolveTemplateType returns the ID of the template type with the given name, and creates the template type with the
+// given body if it is not deployed. The returned flag is true if the template type is created.
+func (manager *TemplateTypeManager) ResolveTemplateType(templateTypeName string, inventory *ResourceInventory,
+	templateTypeBody interface{}) (string, bool, error) {
+
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	templateTypeId, err := manager.GetTemplateTypeId(templateTypeName, inventory)
+	if err != nil || templateTypeId != "" {
+		return templateTypeId, false, err
+	}
+
+	respBody, _, err := SendJsonRequest(http.MethodPost, manager.GetTemplateTypeUrl(""), templateTypeBody, manager.resourceType)
+	if err != nil {
+		return "", false, err
+	}
+	var createdType TemplateType
+	if err := json.Unmarshal(respBody, &createdType); err == nil && createdType.Id != "" {
+		return createdType.Id, true, nil
+	}
+	templateTypeId, err = manager.GetTemplateTypeId(templateTypeName, inventory)
+	if err == nil && templateTypeId == "" {
+		err = fmt.Errorf("template type: %s created, but the ID is not returned", templateTypeName)
+	}
+	return templateTypeId, true, err
+}
+
+// GetTemplateResourceId returns the resource ID of a template, which is the template type ID and the locale separated
+// by a slash.
+func GetTemplateResourceId(templateTypeId string, locale string) string {
+
+	return templateTypeId + "/" + locale
+}
+
+func SplitTemplateResourceId(resourceId string) (string, string) {
+
+	index := strings.LastIndex(resourceId, "/")
+	if index < 0 {
+		return resourceId, ""
+	}
+	return resourceId[:index], resourceId[index+1:]
+}
+
+func GetTemplateResourceName(templateType string, locale string) string {
+
+	return templateType + "_" + locale
+}
+
+func FormatTemplateFileName(resourceName string) string {
+
+	return templateFileNameRegex.ReplaceAllString(resourceName, "_")
+}
@@ -0,0 +1,193 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"path/filepath"
+)
+
+const TENANT_PAGE_SIZE = 100
+
+type tenantList struct {
+	TotalResults int `json:"totalResults"`
+	Tenants      []struct {
+		Domain          string `json:"domain"`
+		LifecycleStatus struct {
+			Activated bool `json:"activated"`
+		} `json:"lifecycleStatus"`
+	} `json:"tenants"`
+}
+
+var (
+	// Tenant of the resources being processed, when multiple tenants are processed in a single run.
+	currentTenant         string
+	defaultServerConfigs  ServerConfigs
+	defaultKeywordConfigs KeywordConfigs
+)
+
+// IsMultiTenant returns true if multiple tenants should be processed in a single run.
+func IsMultiTenant() bool {
+
+	return SERVER_CONFIGS.DiscoverTenants || len(SERVER_CONFIGS.TenantDomains) > 0
+}
+
+// RunForTenants runs the given function for each of the configured tenants with a separate folder of the given
+// directory, or once with the given directory if a single tenant is configured.
+func RunForTenants(dirPath string, run func(tenantDirPath string)) {
+
+	if !IsMultiTenant() {
+		run(dirPath)
+		return
+	}
+
+	tenantDomains, err := GetTenantDomains()
+	if err != nil {
+		ExitWithError(EXIT_CODE_CONFIG_ERROR, "Error when resolving the tenants.", err)
+	}
+	defaultServerConfigs = SERVER_CONFIGS
+	defaultKeywordConfigs = KEYWORD_CONFIGS
+	for _, tenantDomain := range tenantDomains {
+		if IsRunAborted() {
+			break
+		}
+		if err := SwitchTenant(tenantDomain); err != nil {
+			UpdateFailureSummary(TENANTS, tenantDomain)
+			log.Printf("Error when switching to the tenant: %s. %s", tenantDomain, err)
+			continue
+		}
+		log.Printf("Processing the resources of the tenant: %s", tenantDomain)
+		run(filepath.Join(dirPath, tenantDomain))
+	}
+	currentTenant = ""
+}
+
+// GetTenantDomains returns the tenants configured in the server configs. If tenant discovery is enabled, the tenant
+// of the server configs is returned along with the active tenants listed by the tenant management API.
+func GetTenantDomains() ([]string, error) {
+
+	if !SERVER_CONFIGS.DiscoverTenants {
+		return SERVER_CONFIGS.TenantDomains, nil
+	}
+
+	tenantDomains := []string{SERVER_CONFIGS.TenantDomain}
+	for offset := 0; ; offset += TENANT_PAGE_SIZE {
+		query := url.Values{}
+		query.Set("offset", fmt.Sprint(offset))
+		query.Set("limit", fmt.Sprint(TENANT_PAGE_SIZE))
+		body, _, err := SendJsonRequest(http.MethodGet, GetServerBaseUrl()+"/api/server/v1/tenants?"+query.Encode(), nil, TENANTS)
+		if err != nil {
+			return nil, fmt.Errorf("error while retrieving tenant list. %w", err)
+		}
+		var list tenantList
+		if err := json.Unmarshal(body, &list); err != nil {
+			return nil, fmt.Errorf("error when unmarshalling the retrieved tenant list. %w", err)
+		}
+		for _, tenant := range list.Tenants {
+			if tenant.LifecycleStatus.Activated && !Contains(tenantDomains, tenant.Domain) {
+				tenantDomains = append(tenantDomains, tenant.Domain)
+			}
+		}
+		if len(list.Tenants) == 0 || offset+len(list.Tenants) >= list.TotalResults {
+			return tenantDomains, nil
+		}
+	}
+}
+
+// SwitchTenant targets the given tenant in the subsequent requests, with the server configs and keyword configs
+// overridden for the tenant in the config files.
+func SwitchTenant(tenantDomain string) error {
+
+	keywordConfigs, err := ResolveTenantKeywordConfigs(defaultKeywordConfigs, tenantDomain)
+	if err != nil {
+		return fmt.Errorf("keyword configs of the tenant are not in the correct format. %s", err)
+	}
+
+	tokenMutex.Lock()
+	SERVER_CONFIGS = getTenantServerConfigs(tenantDomain)
+	err = refreshAccessToken()
+	tokenMutex.Unlock()
+
+	ClearInventories()
+	KEYWORD_CONFIGS = keywordConfigs
+	currentTenant = tenantDomain
+	return err
+}
+
+func getTenantServerConfigs(tenantDomain string) ServerConfigs {
+
+	serverConfigs := defaultServerConfigs
+	serverConfigs.TenantDomain = tenantDomain
+	serverConfigs.OrganizationId = ""
+	if tenantConfigs, ok := defaultServerConfigs.TenantConfigs[tenantDomain]; ok {
+		if tenantConfigs.ClientId != "" {
+			serverConfigs.ClientId = tenantConfigs.ClientId
+		}
+		if tenantConfigs.ClientSecret != "" {
+			serverConfigs.ClientSecret = tenantConfigs.ClientSecret
+		}
+		if tenantConfigs.OrganizationId != "" {
+			serverConfigs.OrganizationId = tenantConfigs.OrganizationId
+		}
+	}
+	return serverConfigs
+}
+
+// ResolveTenantKeywordConfigs returns the keyword configs of the tenant. The keyword mappings and the resource configs
+// added for the tenant override the default keyword mappings and the resource configs with the same key.
+func ResolveTenantKeywordConfigs(defaultKeywordConfigs KeywordConfigs, tenantDomain string) (KeywordConfigs, error) {
+
+	keywordConfigs := defaultKeywordConfigs
+	tenantConfigFile, ok := defaultKeywordConfigs.TenantConfigs[tenantDomain]
+	if !ok {
+		return keywordConfigs, nil
+	}
+	tenantConfigs, err := parseKeywordConfigs(tenantConfigFile)
+	if err != nil {
+		return keywordConfigs, err
+	}
+
+	keywordConfigs.KeywordMappings = make(map[string]interface{})
+	for keyword, value := range defaultKeywordConfigs.KeywordMappings {
+		keywordConfigs.KeywordMappings[keyword] = value
+	}
+	for keyword, value := range tenantConfigs.KeywordMappings {
+		keywordConfigs.KeywordMappings[keyword] = value
+	}
+
+	keywordConfigs.ResourceConfigs = make(map[string]map[string]interface{})
+	for configKey, resourceConfigs := range defaultKeywordConfigs.ResourceConfigs {
+		keywordConfigs.ResourceConfigs[configKey] = resourceConfigs
+	}
+	for configKey, resourceConfigs := range tenantConfigs.ResourceConfigs {
+		mergedConfigs := make(map[string]interface{})
+		for key, value := range keywordConfigs.ResourceConfigs[configKey] {
+			mergedConfigs[key] = value
+		}
+		for key, value := range resourceConfigs {
+			mergedConfigs[key] = value
+		}
+		keywordConfigs.ResourceConfigs[configKey] = mergedConfigs
+	}
+	return keywordConfigs, nil
+}
@@ -0,0 +1,116 @@
+/**
+* Copyright (c) 2023, WSO2 LLC. (https://www.wso2.com) All Rights Reserved.
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Refresh the access token this long before it expires, so that a request is not sent with an expiring token.
+const TOKEN_EXPIRY_BUFFER = 60 * time.Second
+
+var (
+	tokenExpiry time.Time
+	tokenMutex  sync.Mutex
+)
+
+func RefreshAccessToken() error {
+
+	tokenMutex.Lock()
+	defer tokenMutex.Unlock()
+
+	return refreshAccessToken()
+}
+
+func refreshAccessToken() error {
+
+	response, err := getAccessToken(SERVER_CONFIGS)
+	if err != nil {
+		return err
+	}
+	if SERVER_CONFIGS.OrganizationId != "" {
+		// Switch the token of the root organization to the target organization.
+		response, err = getOrganizationAccessToken(SERVER_CONFIGS, response.AccessToken)
+		if err != nil {
+			return fmt.Errorf("error when switching to the organization: %s. %s", SERVER_CONFIGS.OrganizationId, err)
+		}
+	}
+	SERVER_CONFIGS.Token = response.AccessToken
+	if response.Expires > 0 {
+		tokenExpiry = time.Now().Add(time.Duration(response.Expires) * time.Second)
+	} else {
+		tokenExpiry = time.Time{}
+	}
+	return nil
+}
+
+// ensureValidToken refreshes the access token if it is about to expire and sets it on the given request.
+func ensureValidToken(request *http.Request) {
+
+	if !isBearerTokenRequest(request) {
+		return
+	}
+
+	tokenMutex.Lock()
+	if !tokenExpiry.IsZero() && time.Now().Add(TOKEN_EXPIRY_BUFFER).After(tokenExpiry) {
+		log.Println("Access token is about to expire. Refreshing the access token.")
+		if err := refreshAccessToken(); err != nil {
+			log.Println("Error when refreshing the access token.", err)
+		}
+	}
+	token := SERVER_CONFIGS.Token
+	tokenMutex.Unlock()
+
+	request.Header.Set("Authorization", "Bearer "+token)
+}
+
+// reauthenticate gets a new access token after an unexpected 401 response, unless another request already did.
+func reauthenticate(usedToken string) bool {
+
+	tokenMutex.Lock()
+	defer tokenMutex.Unlock()
+
+	if SERVER_CONFIGS.Token != usedToken {
+		return true
+	}
+	log.Println("Unauthorized response received. Re-authenticating with the server.")
+	if err := refreshAccessToken(); err != nil {
+		log.Println("Error when re-authenticating with the server.", err)
+		return false
+	}
+	return true
+}
+
+func currentAccessToken() string {
+
+	tokenMutex.Lock()
+	defer tokenMutex.Unlock()
+
+	return SERVER_CONFIGS.Token
+}
+
+func isBearerTokenRequest(request *http.Request) bool {
+
+	return strings.HasPrefix(request.Header.Get("Authorization"), "Bearer ")
+}
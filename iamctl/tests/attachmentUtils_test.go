@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+type testAttachmentHandler struct {
+	testResourceHandler
+}
+
+func (h *testAttachmentHandler) ExportResource(resource utils.Resource, format string, excludeSecrets bool) (string, []byte, error) {
+	return resource.Name + ".yml", []byte("name: " + resource.Name + "\nbody: <a href=\"https://dev.wso2.com\">Login</a>\n"), nil
+}
+
+func (h *testAttachmentHandler) ExtractAttachments(resource utils.Resource, fileName string,
+	content []byte) ([]byte, map[string][]byte, error) {
+
+	var config map[string]string
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return nil, nil, err
+	}
+	bodyFileName := utils.GetFileInfo(fileName).ResourceName + ".html"
+	attachments := map[string][]byte{bodyFileName: []byte(config["body"])}
+	delete(config, "body")
+	config["bodyFile"] = bodyFileName
+	content, err := yaml.Marshal(config)
+	return content, attachments, err
+}
+
+func (h *testAttachmentHandler) IsAttachment(fileName string) bool {
+	return filepath.Ext(fileName) == ".html"
+}
+
+func TestGetExportedContentWithAttachments(t *testing.T) {
+
+	outputDir := t.TempDir()
+	localAttachment := "<a href=\"{{LOGIN_URL}}\">Login</a>"
+	if err := ioutil.WriteFile(filepath.Join(outputDir, "Welcome.html"), []byte(localAttachment), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	originalKeywordConfigs := utils.KEYWORD_CONFIGS
+	defer func() { utils.KEYWORD_CONFIGS = originalKeywordConfigs }()
+
+	testCases := []struct {
+		description string
+		loginUrl    string
+		expected    string
+	}{
+		{description: "Local attachment resolves to the deployed content", loginUrl: "https://dev.wso2.com",
+			expected: localAttachment},
+		{description: "Local attachment differs from the deployed content", loginUrl: "https://prod.wso2.com",
+			expected: "<a href=\"https://dev.wso2.com\">Login</a>"},
+	}
+	handler := &testAttachmentHandler{testResourceHandler{resourceType: "TestTemplates"}}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			utils.KEYWORD_CONFIGS.KeywordMappings = map[string]interface{}{"LOGIN_URL": tc.loginUrl}
+			fileName, content, attachments, err := utils.GetExportedContent(handler, utils.Resource{Name: "Welcome"},
+				outputDir, "yaml", true)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if fileName != filepath.Join(outputDir, "Welcome.yml") || string(content) != "bodyFile: Welcome.html\nname: Welcome\n" {
+				t.Errorf("Unexpected exported file: %s\n%s", fileName, string(content))
+			}
+			if attachment := string(attachments[filepath.Join(outputDir, "Welcome.html")]); attachment != tc.expected {
+				t.Errorf("Unexpected attachment: expected %s, but got %s", tc.expected, attachment)
+			}
+		})
+	}
+	if !utils.IsAttachmentFile(handler, "Welcome.html") || utils.IsAttachmentFile(handler, "Welcome.yml") {
+		t.Errorf("Unexpected result when checking the attachment files")
+	}
+}
+
+func TestRemoveDeletedLocalResourcesWithAttachments(t *testing.T) {
+
+	localDir := t.TempDir()
+	for _, fileName := range []string{"Welcome.yml", "Welcome.html", "Welcome.v2.yml", "Welcome.v2.html", "Expired.yml", "Expired.html"} {
+		if err := ioutil.WriteFile(filepath.Join(localDir, fileName), []byte("name: test"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	handler := &testAttachmentHandler{testResourceHandler{resourceType: "TestTemplates"}}
+	utils.RemoveDeletedLocalResources(handler, localDir, []string{"Welcome.v2"})
+
+	files, err := ioutil.ReadDir(localDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var remainingFiles []string
+	for _, file := range files {
+		remainingFiles = append(remainingFiles, file.Name())
+	}
+	expected := []string{"Welcome.v2.html", "Welcome.v2.yml"}
+	if !reflect.DeepEqual(remainingFiles, expected) {
+		t.Errorf("Unexpected local files: expected %v, but got %v", expected, remainingFiles)
+	}
+}
@@ -0,0 +1,235 @@
+package tests
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestBackupArchiveRoundTrip(t *testing.T) {
+
+	sourceDir := t.TempDir()
+	files := map[string]string{
+		"Applications/Dispatch.yml":         "applicationName: Dispatch",
+		"IdentityProviders/Google.yml":      "identityProviderName: Google",
+		"ServerConfigurations/Password.yml": "enabled: true",
+	}
+	for name, content := range files {
+		filePath := filepath.Join(sourceDir, filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(filePath), 0700)
+		ioutil.WriteFile(filePath, []byte(content), 0600)
+	}
+
+	manifest := utils.BackupManifest{
+		ServerUrl:    "https://localhost:9443",
+		TenantDomain: "carbon.super",
+		CreatedAt:    time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC),
+		ToolVersion:  "1.0.0",
+	}
+	archivePath := filepath.Join(t.TempDir(), "backup.tar.gz")
+	if err := utils.WriteBackupArchive(sourceDir, archivePath, manifest); err != nil {
+		t.Fatalf("Unexpected error when writing the archive: %s", err)
+	}
+
+	destDir := t.TempDir()
+	extractedManifest, err := utils.ExtractBackupArchive(archivePath, destDir)
+	if err != nil {
+		t.Fatalf("Unexpected error when extracting the archive: %s", err)
+	}
+	if extractedManifest.ServerUrl != manifest.ServerUrl || extractedManifest.TenantDomain != manifest.TenantDomain ||
+		extractedManifest.ToolVersion != manifest.ToolVersion || !extractedManifest.CreatedAt.Equal(manifest.CreatedAt) {
+		t.Errorf("Unexpected manifest: %+v", extractedManifest)
+	}
+	if len(extractedManifest.Files) != len(files) {
+		t.Errorf("Expected %d files in the manifest, but got %d", len(files), len(extractedManifest.Files))
+	}
+	for name, content := range files {
+		extracted, err := ioutil.ReadFile(filepath.Join(destDir, filepath.FromSlash(name)))
+		if err != nil || string(extracted) != content {
+			t.Errorf("Unexpected content for the file: %s", name)
+		}
+	}
+}
+
+func TestExtractInvalidBackupArchive(t *testing.T) {
+
+	validManifest := `{"files": {"Applications/Dispatch.yml": "` +
+		`a36bf3d2a1a8a4bb3a4bfa2f1a7e2a9f44b9cb6d4f0c3cb9a9cc1e5e3b1b6f3e"}}`
+
+	testCases := []struct {
+		description   string
+		entries       map[string]string
+		expectedError string
+	}{
+		{
+			description: "Modified file",
+			entries: map[string]string{
+				"manifest.json":             validManifest,
+				"Applications/Dispatch.yml": "applicationName: Modified",
+			},
+			expectedError: "checksum mismatch",
+		},
+		{
+			description: "File not in the manifest",
+			entries: map[string]string{
+				"manifest.json":           `{"files": {}}`,
+				"Applications/Pickup.yml": "applicationName: Pickup",
+			},
+			expectedError: "not listed in the backup manifest",
+		},
+		{
+			description: "Missing file",
+			entries: map[string]string{
+				"manifest.json": validManifest,
+			},
+			expectedError: "not found",
+		},
+		{
+			description: "Path outside the archive",
+			entries: map[string]string{
+				"manifest.json":   `{"files": {}}`,
+				"../Dispatch.yml": "applicationName: Dispatch",
+			},
+			expectedError: "invalid entry",
+		},
+		{
+			description: "Missing manifest",
+			entries: map[string]string{
+				"Applications/Dispatch.yml": "applicationName: Dispatch",
+			},
+			expectedError: "manifest not found",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			archivePath := filepath.Join(t.TempDir(), "backup.tar.gz")
+			writeTestArchive(t, archivePath, tc.entries)
+
+			_, err := utils.ExtractBackupArchive(archivePath, t.TempDir())
+			if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+				t.Errorf("Expected an error containing '%s', but got: %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestPruneBackups(t *testing.T) {
+
+	now := time.Now().UTC()
+	archiveNames := []string{
+		getTestBackupName("carbon.super", now.Add(-1*time.Hour)),
+		getTestBackupName("carbon.super", now.Add(-50*time.Hour)),
+		getTestBackupName("carbon.super", now.Add(-100*time.Hour)),
+		getTestBackupName("wso2.com", now.Add(-100*time.Hour)),
+		"notes.txt",
+	}
+
+	testCases := []struct {
+		description     string
+		retainCount     int
+		retainDays      int
+		expectedRemoved []string
+	}{
+		{
+			description:     "No retention limits",
+			expectedRemoved: nil,
+		},
+		{
+			description:     "Retain by count",
+			retainCount:     1,
+			expectedRemoved: archiveNames[1:3],
+		},
+		{
+			description:     "Retain by age",
+			retainDays:      3,
+			expectedRemoved: archiveNames[2:3],
+		},
+		{
+			description:     "Retain by count and age",
+			retainCount:     2,
+			retainDays:      1,
+			expectedRemoved: archiveNames[1:3],
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			backupDir := t.TempDir()
+			for _, name := range archiveNames {
+				ioutil.WriteFile(filepath.Join(backupDir, name), []byte{}, 0600)
+			}
+
+			removed, err := utils.PruneBackups(backupDir, "carbon.super", tc.retainCount, tc.retainDays)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			var removedNames []string
+			for _, removedPath := range removed {
+				removedNames = append(removedNames, filepath.Base(removedPath))
+				if _, err := os.Stat(removedPath); !os.IsNotExist(err) {
+					t.Errorf("Archive not removed: %s", removedPath)
+				}
+			}
+			sort.Strings(removedNames)
+			expectedRemoved := append([]string(nil), tc.expectedRemoved...)
+			sort.Strings(expectedRemoved)
+			if !reflect.DeepEqual(removedNames, expectedRemoved) {
+				t.Errorf("Expected removed archives %v, but got %v", expectedRemoved, removedNames)
+			}
+		})
+	}
+}
+
+func getTestBackupName(tenantDomain string, createdAt time.Time) string {
+
+	return utils.BACKUP_FILE_PREFIX + tenantDomain + "-" + createdAt.Format(utils.BACKUP_TIME_FORMAT) +
+		utils.BACKUP_FILE_EXTENSION
+}
+
+func writeTestArchive(t *testing.T, archivePath string, entries map[string]string) {
+
+	archiveFile, err := os.Create(archivePath)
+	if err != nil {
+		t.Fatalf("Error when creating the archive: %s", err)
+	}
+	defer archiveFile.Close()
+	gzipWriter := gzip.NewWriter(archiveFile)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range entries {
+		if name == utils.BACKUP_MANIFEST_FILE && !json.Valid([]byte(content)) {
+			t.Fatalf("Invalid test manifest: %s", content)
+		}
+		tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content))})
+		tarWriter.Write([]byte(content))
+	}
+	tarWriter.Close()
+	gzipWriter.Close()
+}
+
+func TestRestoreBackupFromDifferentEnvironment(t *testing.T) {
+
+	sourceDir := t.TempDir()
+	manifest := utils.BackupManifest{ServerUrl: "https://prod.example.com", TenantDomain: "carbon.super"}
+	archivePath := filepath.Join(t.TempDir(), "backup.tar.gz")
+	if err := utils.WriteBackupArchive(sourceDir, archivePath, manifest); err != nil {
+		t.Fatalf("Unexpected error when writing the archive: %s", err)
+	}
+
+	defaultServerConfigs := utils.SERVER_CONFIGS
+	defer func() { utils.SERVER_CONFIGS = defaultServerConfigs }()
+	utils.SERVER_CONFIGS = utils.ServerConfigs{ServerUrl: "https://dev.example.com", TenantDomain: "carbon.super"}
+
+	err := utils.RestoreBackup(archivePath, false)
+	if err == nil || !strings.Contains(err.Error(), "force") {
+		t.Errorf("Expected the restore to be refused without the force flag, but got: %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	_ "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/branding"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestBrandingResourceRoundTrip(t *testing.T) {
+
+	// Records the branding preferences deleted through the API, where the custom text of the screens does not exist.
+	var deletedTargets []url.Values
+	var mutex sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			mutex.Lock()
+			deletedTargets = append(deletedTargets, r.URL.Query())
+			mutex.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	defaultServerConfigs := utils.SERVER_CONFIGS
+	defer func() {
+		utils.SERVER_CONFIGS = defaultServerConfigs
+	}()
+	utils.SERVER_CONFIGS = utils.ServerConfigs{ServerUrl: server.URL, TenantDomain: "carbon.super", Token: "token"}
+
+	handler := utils.GetResourceHandler(utils.BRANDING)
+	if handler == nil {
+		t.Fatal("Branding handler is not registered")
+	}
+	inventory := utils.NewResourceInventory([]utils.Resource{
+		{Id: "APP/c2b6f7d4/en-US", Name: "APP_my_app_en-US"},
+		{Id: "APP/9a1e3c55/en-US", Name: "APP_my_en-US"},
+		{Id: "ORG/carbon.super/fr-FR", Name: "ORG_fr-FR"},
+	})
+
+	testCases := []struct {
+		description    string
+		fileData       string
+		expectedName   string
+		expectedTarget url.Values
+	}{
+		{
+			description:    "Application name with underscores",
+			fileData:       "type: APP\napplication: my_app\nlocale: en-US\npreference: {}",
+			expectedName:   "APP_my_app_en-US",
+			expectedTarget: url.Values{"type": {"APP"}, "name": {"c2b6f7d4"}, "locale": {"en-US"}},
+		},
+		{
+			description:    "Application name that is a prefix of another application name",
+			fileData:       "type: APP\napplication: my\nlocale: en-US\npreference: {}",
+			expectedName:   "APP_my_en-US",
+			expectedTarget: url.Values{"type": {"APP"}, "name": {"9a1e3c55"}, "locale": {"en-US"}},
+		},
+		{
+			description:    "Organization branding",
+			fileData:       "type: ORG\nlocale: fr-FR\npreference: {}",
+			expectedName:   "ORG_fr-FR",
+			expectedTarget: url.Values{"type": {"ORG"}, "name": {"carbon.super"}, "locale": {"fr-FR"}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			deletedTargets = nil
+			resource, err := handler.ResolveLocalResource([]byte(tc.fileData), utils.FileInfo{ResourceName: tc.expectedName}, inventory)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if resource.Name != tc.expectedName || resource.Id == "" {
+				t.Fatalf("Expected the deployed branding %s, but got %v", tc.expectedName, resource)
+			}
+
+			if err := handler.DeleteResource(resource); err != nil {
+				t.Fatalf("Unexpected error when deleting the branding: %s", err)
+			}
+			if len(deletedTargets) != 1 {
+				t.Fatalf("Expected a single branding preference to be deleted, but got %d", len(deletedTargets))
+			}
+			for key, value := range tc.expectedTarget {
+				if deletedTargets[0].Get(key) != value[0] {
+					t.Errorf("Expected %s of the branding preference to be %s, but got %s", key, value[0], deletedTargets[0].Get(key))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestCompareResourceContent(t *testing.T) {
+
+	testCases := []struct {
+		description     string
+		localContent    string
+		deployedContent string
+		resourceType    string
+		expectedDiffs   []utils.FieldDiff
+	}{
+		{
+			description: "Identical content with reordered keys",
+			localContent: `applicationName: App1
+description: Sample app
+`,
+			deployedContent: `description: Sample app
+applicationName: App1
+`,
+			resourceType:  utils.APPLICATIONS,
+			expectedDiffs: nil,
+		},
+		{
+			description: "Reordered array elements matched by identifier",
+			localContent: `spProperties:
+- name: prop1
+  value: a
+- name: prop2
+  value: b
+`,
+			deployedContent: `spProperties:
+- name: prop2
+  value: b
+- name: prop1
+  value: a
+`,
+			resourceType:  utils.APPLICATIONS,
+			expectedDiffs: nil,
+		},
+		{
+			description: "Changed field inside an array element",
+			localContent: `claimMappings:
+- localClaim:
+    claimUri: http://wso2.org/claims/email
+  requested: true
+`,
+			deployedContent: `claimMappings:
+- localClaim:
+    claimUri: http://wso2.org/claims/email
+  requested: false
+`,
+			resourceType: utils.APPLICATIONS,
+			expectedDiffs: []utils.FieldDiff{
+				{
+					Path:          "claimMappings.[localClaim.claimUri=http://wso2.org/claims/email].requested",
+					Type:          utils.FIELD_CHANGED,
+					LocalValue:    "true",
+					DeployedValue: "false",
+				},
+			},
+		},
+		{
+			description: "Reordered string arrays and added elements",
+			localContent: `permissions:
+- /permission/a
+- /permission/b
+`,
+			deployedContent: `permissions:
+- /permission/c
+- /permission/b
+- /permission/a
+`,
+			resourceType: utils.IDENTITY_PROVIDERS,
+			expectedDiffs: []utils.FieldDiff{
+				{
+					Path:          "permissions",
+					Type:          utils.FIELD_ONLY_DEPLOYED,
+					DeployedValue: "/permission/c",
+				},
+			},
+		},
+		{
+			description: "Fields present only on one side",
+			localContent: `name: US1
+description: Local
+`,
+			deployedContent: `name: US1
+className: org.wso2.Sample
+`,
+			resourceType: utils.USERSTORES,
+			expectedDiffs: []utils.FieldDiff{
+				{
+					Path:          "className",
+					Type:          utils.FIELD_ONLY_DEPLOYED,
+					DeployedValue: "org.wso2.Sample",
+				},
+				{
+					Path:       "description",
+					Type:       utils.FIELD_ONLY_LOCAL,
+					LocalValue: "Local",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			diffs, err := utils.CompareResourceContent([]byte(tc.localContent), []byte(tc.deployedContent), tc.resourceType)
+			if err != nil {
+				t.Fatalf("Unexpected error for %s: %v", tc.description, err)
+			}
+			if !reflect.DeepEqual(diffs, tc.expectedDiffs) {
+				t.Errorf("Unexpected result for %s: expected %v, but got %v", tc.description, tc.expectedDiffs, diffs)
+			}
+		})
+	}
+}
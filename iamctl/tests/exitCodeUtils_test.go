@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestGetExitCode(t *testing.T) {
+
+	testCases := []struct {
+		description      string
+		successfulCount  int
+		failedCount      int
+		expectedExitCode int
+	}{
+		{
+			description:      "All operations successful",
+			successfulCount:  3,
+			expectedExitCode: utils.EXIT_CODE_SUCCESS,
+		},
+		{
+			description:      "No operations",
+			expectedExitCode: utils.EXIT_CODE_SUCCESS,
+		},
+		{
+			description:      "Some operations failed",
+			successfulCount:  3,
+			failedCount:      1,
+			expectedExitCode: utils.EXIT_CODE_PARTIAL_FAILURE,
+		},
+		{
+			description:      "All operations failed",
+			failedCount:      2,
+			expectedExitCode: utils.EXIT_CODE_TOTAL_FAILURE,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			utils.ResetRunState()
+			for i := 0; i < tc.successfulCount; i++ {
+				utils.UpdateSuccessSummary(utils.APPLICATIONS, utils.IMPORT)
+			}
+			for i := 0; i < tc.failedCount; i++ {
+				utils.UpdateFailureSummary(utils.APPLICATIONS, "app")
+			}
+			if exitCode := utils.GetExitCode(); exitCode != tc.expectedExitCode {
+				t.Errorf("Expected exit code %d, but got %d", tc.expectedExitCode, exitCode)
+			}
+		})
+	}
+}
+
+func TestFailFast(t *testing.T) {
+
+	utils.ResetRunState()
+	utils.FAIL_FAST = true
+	utils.PARALLELISM = 1
+	defer func() {
+		utils.FAIL_FAST = false
+		utils.ResetRunState()
+	}()
+
+	var processed int
+	utils.RunInParallel(10, func(index int) {
+		processed++
+		if index == 2 {
+			utils.UpdateFailureSummary(utils.APPLICATIONS, "app")
+		}
+	})
+	if !utils.IsRunAborted() || processed != 3 {
+		t.Errorf("Expected the run to stop after the first failure, but %d tasks were processed", processed)
+	}
+}
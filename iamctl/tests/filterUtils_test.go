@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestMatchesResourcePattern(t *testing.T) {
+
+	attributes := map[string][]string{"inboundProtocol": {"oauth2", "saml"}}
+
+	testCases := []struct {
+		description  string
+		pattern      string
+		resourceName string
+		expected     bool
+	}{
+		{description: "Exact name", pattern: "Pickup", resourceName: "Pickup", expected: true},
+		{description: "Different name", pattern: "Pickup", resourceName: "Dispatch", expected: false},
+		{description: "Glob pattern", pattern: "team-payments-*", resourceName: "team-payments-api", expected: true},
+		{description: "Glob pattern not matched", pattern: "team-payments-*", resourceName: "team-orders-api", expected: false},
+		{description: "Glob character class", pattern: "app-[0-9]", resourceName: "app-7", expected: true},
+		{description: "Name with glob characters", pattern: "Console [Legacy]", resourceName: "Console [Legacy]", expected: true},
+		{description: "Regex pattern", pattern: "regex:team-(payments|orders)-.+", resourceName: "team-orders-web", expected: true},
+		{description: "Regex pattern is anchored", pattern: "regex:payments", resourceName: "team-payments-api", expected: false},
+		{description: "Invalid regex pattern", pattern: "regex:team-(", resourceName: "team-(", expected: false},
+		{description: "Attribute pattern", pattern: "attr:inboundProtocol=saml", resourceName: "Pickup", expected: true},
+		{description: "Attribute glob pattern", pattern: "attr:inboundProtocol=oauth*", resourceName: "Pickup", expected: true},
+		{description: "Attribute pattern not matched", pattern: "attr:inboundProtocol=passive-sts", resourceName: "Pickup", expected: false},
+		{description: "Unknown attribute", pattern: "attr:authenticator=saml", resourceName: "Pickup", expected: false},
+		{description: "Invalid attribute pattern", pattern: "attr:inboundProtocol", resourceName: "Pickup", expected: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := utils.MatchesResourcePattern(tc.pattern, tc.resourceName, func() map[string][]string { return attributes })
+			if result != tc.expected {
+				t.Errorf("Expected %v for the pattern %s and the resource %s, but got %v", tc.expected, tc.pattern, tc.resourceName, result)
+			}
+		})
+	}
+}
+
+type testAttributeResourceHandler struct {
+	testResourceHandler
+	attributes map[string][]string
+	calls      int
+}
+
+func (h *testAttributeResourceHandler) GetDeployedAttributes(resource utils.Resource) (map[string][]string, error) {
+
+	h.calls++
+	if resource.Id == "" {
+		return nil, fmt.Errorf("resource not deployed")
+	}
+	return h.attributes, nil
+}
+
+func (h *testAttributeResourceHandler) GetLocalAttributes(fileData []byte) (map[string][]string, error) {
+
+	h.calls++
+	return map[string][]string{"authenticator": {string(fileData)}}, nil
+}
+
+func TestIsResourceExcludedByAttributes(t *testing.T) {
+
+	resourceConfigs := map[string]interface{}{
+		"INCLUDE_ONLY": []interface{}{"Pickup", "attr:authenticator=GoogleOIDCAuthenticator"},
+	}
+	handler := &testAttributeResourceHandler{
+		testResourceHandler: testResourceHandler{resourceType: "TestFilterIdps"},
+		attributes:          map[string][]string{"authenticator": {"SAMLSSOAuthenticator", "GoogleOIDCAuthenticator"}},
+	}
+
+	if utils.IsDeployedResourceExcluded(handler, utils.Resource{Id: "1", Name: "Pickup"}, resourceConfigs) {
+		t.Errorf("Resource matching by name is excluded")
+	}
+	if handler.calls != 0 {
+		t.Errorf("Attributes retrieved for a resource matching by name")
+	}
+	if utils.IsDeployedResourceExcluded(handler, utils.Resource{Id: "2", Name: "Google"}, resourceConfigs) {
+		t.Errorf("Deployed resource matching by attributes is excluded")
+	}
+	if !utils.IsDeployedResourceExcluded(handler, utils.Resource{Name: "Unknown"}, resourceConfigs) {
+		t.Errorf("Resource without attributes is not excluded")
+	}
+
+	localResource := utils.LocalResource{Resource: utils.Resource{Name: "Okta"}, FileData: "OpenIDConnectAuthenticator"}
+	if !utils.IsLocalResourceExcluded(handler, localResource, resourceConfigs) {
+		t.Errorf("Local resource not matching by attributes is not excluded")
+	}
+	localResource.FileData = "GoogleOIDCAuthenticator"
+	if utils.IsLocalResourceExcluded(handler, localResource, resourceConfigs) {
+		t.Errorf("Local resource matching by attributes is excluded")
+	}
+}
+
+func TestAddResourceFilters(t *testing.T) {
+
+	defaultToolConfigs := utils.TOOL_CONFIGS
+	defer func() { utils.TOOL_CONFIGS = defaultToolConfigs }()
+	utils.TOOL_CONFIGS = utils.ToolConfigs{Exclude: []string{"Claims"}}
+
+	err := utils.AddResourceFilters([]string{"Applications", "Identity*"}, []string{"Users"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if expected := []string{"Applications", "Identity*"}; !reflect.DeepEqual(utils.TOOL_CONFIGS.IncludeOnly, expected) {
+		t.Errorf("Unexpected INCLUDE_ONLY config: expected %v, but got %v", expected, utils.TOOL_CONFIGS.IncludeOnly)
+	}
+	if expected := []string{"Claims", "Users"}; !reflect.DeepEqual(utils.TOOL_CONFIGS.Exclude, expected) {
+		t.Errorf("Unexpected EXCLUDE config: expected %v, but got %v", expected, utils.TOOL_CONFIGS.Exclude)
+	}
+	if utils.IsResourceTypeExcluded("IdentityProviders") || !utils.IsResourceTypeExcluded("Roles") {
+		t.Errorf("Unexpected result when filtering resource types by the added patterns")
+	}
+	for _, pattern := range []string{"", "regex:Identity.*", "attr:inboundProtocol=saml", "UnknownType:Pickup", "app-[0-9"} {
+		if err := utils.AddResourceFilters([]string{pattern}, nil); err == nil {
+			t.Errorf("Expected an error for the pattern: %s", pattern)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+// writeTestCertificate writes a self-signed certificate and its private key to the given directory in PEM format.
+func writeTestCertificate(t *testing.T, dir string) (certPath string, keyPath string) {
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "iamctl-test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPath = filepath.Join(dir, "cert.pem")
+	keyPath = filepath.Join(dir, "key.pem")
+	if err := ioutil.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return certPath, keyPath
+}
+
+func TestBuildTLSConfig(t *testing.T) {
+
+	dir := t.TempDir()
+	certPath, keyPath := writeTestCertificate(t, dir)
+	invalidPemPath := filepath.Join(dir, "invalid.pem")
+	if err := ioutil.WriteFile(invalidPemPath, []byte("not a certificate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		description        string
+		config             utils.ServerConfigs
+		expectError        bool
+		insecureSkipVerify bool
+		customRootCAs      bool
+		clientCertificates int
+	}{
+		{
+			description: "Default configs verify the server certificate",
+			config:      utils.ServerConfigs{},
+		},
+		{
+			description:        "Certificate verification disabled",
+			config:             utils.ServerConfigs{InsecureSkipVerify: true},
+			insecureSkipVerify: true,
+		},
+		{
+			description:   "CA certificate bundle",
+			config:        utils.ServerConfigs{CaCertPath: certPath},
+			customRootCAs: true,
+		},
+		{
+			description: "Missing CA certificate bundle",
+			config:      utils.ServerConfigs{CaCertPath: filepath.Join(dir, "missing.pem")},
+			expectError: true,
+		},
+		{
+			description: "CA certificate bundle without certificates",
+			config:      utils.ServerConfigs{CaCertPath: invalidPemPath},
+			expectError: true,
+		},
+		{
+			description:        "Client certificate for mutual TLS",
+			config:             utils.ServerConfigs{ClientCertPath: certPath, ClientKeyPath: keyPath},
+			clientCertificates: 1,
+		},
+		{
+			description: "Client certificate without the private key",
+			config:      utils.ServerConfigs{ClientCertPath: certPath},
+			expectError: true,
+		},
+		{
+			description: "Invalid client certificate",
+			config:      utils.ServerConfigs{ClientCertPath: invalidPemPath, ClientKeyPath: keyPath},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			tlsConfig, err := utils.BuildTLSConfig(tc.config)
+			if tc.expectError {
+				if err == nil {
+					t.Errorf("Expected an error, but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if tlsConfig.MinVersion != tls.VersionTLS12 {
+				t.Errorf("Expected the minimum TLS version to be TLS 1.2, but got %d", tlsConfig.MinVersion)
+			}
+			if tlsConfig.InsecureSkipVerify != tc.insecureSkipVerify {
+				t.Errorf("Expected InsecureSkipVerify to be %t, but got %t", tc.insecureSkipVerify, tlsConfig.InsecureSkipVerify)
+			}
+			if (tlsConfig.RootCAs != nil) != tc.customRootCAs {
+				t.Errorf("Expected custom root CAs to be %t, but got %t", tc.customRootCAs, tlsConfig.RootCAs != nil)
+			}
+			if len(tlsConfig.Certificates) != tc.clientCertificates {
+				t.Errorf("Expected %d client certificates, but got %d", tc.clientCertificates, len(tlsConfig.Certificates))
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestResourceInventory(t *testing.T) {
+
+	inventory := utils.NewResourceInventory([]utils.Resource{
+		{Id: "1", Name: "App 1"},
+		{Id: "2", Name: "App 2"},
+	})
+
+	// Add a created resource, rename a resource and remove a deleted resource.
+	inventory.Put(utils.Resource{Id: "3", Name: "App 3"})
+	inventory.Put(utils.Resource{Id: "2", Name: "Renamed App"})
+	inventory.Remove(utils.Resource{Id: "1", Name: "App 1"})
+
+	expected := []utils.Resource{
+		{Id: "2", Name: "Renamed App"},
+		{Id: "3", Name: "App 3"},
+	}
+	if resources := inventory.List(); !reflect.DeepEqual(resources, expected) {
+		t.Errorf("Unexpected inventory: expected %v, but got %v", expected, resources)
+	}
+
+	testCases := []struct {
+		description string
+		name        string
+		id          string
+		exists      bool
+	}{
+		{description: "Existing resource", name: "App 3", id: "3", exists: true},
+		{description: "Renamed resource", name: "Renamed App", id: "2", exists: true},
+		{description: "Previous name of a renamed resource", name: "App 2", exists: false},
+		{description: "Removed resource", name: "App 1", exists: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			resource, ok := inventory.GetByName(tc.name)
+			if ok != tc.exists || resource.Id != tc.id {
+				t.Errorf("Unexpected result for %s: expected (%s, %v), but got (%s, %v)", tc.name, tc.id, tc.exists, resource.Id, ok)
+			}
+			if tc.exists {
+				if _, ok := inventory.GetById(tc.id); !ok {
+					t.Errorf("Resource with ID %s not found", tc.id)
+				}
+			}
+		})
+	}
+}
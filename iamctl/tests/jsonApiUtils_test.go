@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+func TestJsonToYaml(t *testing.T) {
+
+	jsonContent := []byte(`{"id":"1234","displayName":"manager","permissions":["/permission/admin/login"],"meta":{"location":"https://localhost"}}`)
+	yamlContent, err := utils.JsonToYaml(jsonContent, "id", "meta")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	var result map[string]interface{}
+	if err := yaml.Unmarshal(yamlContent, &result); err != nil {
+		t.Fatalf("Invalid YAML content: %s", err)
+	}
+	expected := map[string]interface{}{
+		"displayName": "manager",
+		"permissions": []interface{}{"/permission/admin/login"},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestYamlToMap(t *testing.T) {
+
+	testCases := []struct {
+		description  string
+		yamlContent  string
+		expectedJson string
+		expectError  bool
+	}{
+		{
+			description:  "Nested YAML content",
+			yamlContent:  "displayName: manager\npermissions:\n  - value: /permission/admin/login\nmeta:\n  version: 1\n",
+			expectedJson: `{"displayName":"manager","meta":{"version":1},"permissions":[{"value":"/permission/admin/login"}]}`,
+		},
+		{
+			description: "YAML content that is not an object",
+			yamlContent: "- manager\n",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result, err := utils.YamlToMap([]byte(tc.yamlContent))
+			if tc.expectError {
+				if err == nil {
+					t.Errorf("Expected an error for %s", tc.description)
+				}
+				return
+			}
+			jsonContent, err := json.Marshal(result)
+			if err != nil {
+				t.Fatalf("Content cannot be marshalled to JSON: %s", err)
+			}
+			if string(jsonContent) != tc.expectedJson {
+				t.Errorf("Expected %s, but got %s", tc.expectedJson, jsonContent)
+			}
+		})
+	}
+}
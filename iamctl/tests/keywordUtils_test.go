@@ -72,10 +72,12 @@ func TestResolveAdvancedKeywordMapping(t *testing.T) {
 				KeywordMappings: map[string]interface{}{
 					"CALLBACK_DOMAIN": "dev.env",
 				},
-				ApplicationConfigs: map[string]interface{}{
-					"App1": map[string]interface{}{
-						"KEYWORD_MAPPINGS": map[string]interface{}{
-							"CALLBACK_DOMAIN": "dev-app1.env",
+				ResourceConfigs: map[string]map[string]interface{}{
+					utils.APPLICATIONS_CONFIG: {
+						"App1": map[string]interface{}{
+							"KEYWORD_MAPPINGS": map[string]interface{}{
+								"CALLBACK_DOMAIN": "dev-app1.env",
+							},
 						},
 					},
 				},
@@ -91,10 +93,12 @@ func TestResolveAdvancedKeywordMapping(t *testing.T) {
 				KeywordMappings: map[string]interface{}{
 					"CALLBACK_DOMAIN": "dev.env",
 				},
-				ApplicationConfigs: map[string]interface{}{
-					"App1": map[string]interface{}{
-						"KEYWORD_MAPPINGS": map[string]interface{}{
-							"CALLBACK_DOMAIN": "dev-app1.env",
+				ResourceConfigs: map[string]map[string]interface{}{
+					utils.APPLICATIONS_CONFIG: {
+						"App1": map[string]interface{}{
+							"KEYWORD_MAPPINGS": map[string]interface{}{
+								"CALLBACK_DOMAIN": "dev-app1.env",
+							},
 						},
 					},
 				},
@@ -108,7 +112,7 @@ func TestResolveAdvancedKeywordMapping(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.description, func(t *testing.T) {
 			utils.KEYWORD_CONFIGS = tc.keywordConfig
-			result := utils.ResolveAdvancedKeywordMapping(tc.resourceName, tc.keywordConfig.ApplicationConfigs)
+			result := utils.ResolveAdvancedKeywordMapping(tc.resourceName, tc.keywordConfig.ResourceConfigs[utils.APPLICATIONS_CONFIG])
 			if !reflect.DeepEqual(result, tc.expectedResult) {
 				t.Errorf("Unexpected result for %s: expected %v, but got %v", tc.description, tc.expectedResult, result)
 			}
@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	_ "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/oidcScopes"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+const oidcClaimDialect = "http://wso2.org/oidc/claim"
+
+// testClaimHandler lists the OIDC claim dialect as deployed, and resolves the claim dialect files by the dialect URI.
+type testClaimHandler struct {
+	testResourceHandler
+}
+
+func (h *testClaimHandler) GetDeployedResources() ([]utils.Resource, error) {
+
+	return []utils.Resource{{Id: "b2lkYw", Name: oidcClaimDialect}}, nil
+}
+
+func (h *testClaimHandler) ResolveLocalResource(fileData []byte, fileInfo utils.FileInfo,
+	inventory *utils.ResourceInventory) (utils.Resource, error) {
+
+	var dialect struct {
+		DialectURI string `yaml:"dialectURI"`
+	}
+	err := yaml.Unmarshal(fileData, &dialect)
+	return utils.Resource{Name: dialect.DialectURI}, err
+}
+
+func TestOidcScopeClaimValidation(t *testing.T) {
+
+	// The OIDC claim dialect of the target environment contains the email claim.
+	var updatedScopes []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/claim-dialects/b2lkYw/claims"):
+			w.Write([]byte(`[{"id": "ZW1haWw", "claimURI": "email"}]`))
+		case r.Method == http.MethodPut && strings.Contains(r.URL.Path, "/oidc/scopes/"):
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			updatedScopes = append(updatedScopes, body)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	defaultServerConfigs := utils.SERVER_CONFIGS
+	defer func() {
+		utils.SERVER_CONFIGS = defaultServerConfigs
+		utils.ClearInventories()
+	}()
+	utils.SERVER_CONFIGS = utils.ServerConfigs{ServerUrl: server.URL, TenantDomain: "carbon.super", Token: "token"}
+	utils.RegisterResourceHandler(&testClaimHandler{testResourceHandler{resourceType: utils.CLAIMS}})
+	utils.ClearInventories()
+
+	handler := utils.GetResourceHandler(utils.OIDC_SCOPES)
+	if handler == nil {
+		t.Fatal("OIDC scope handler is not registered")
+	}
+
+	// The phone_number claim is added to the OIDC claim dialect in the local files.
+	inputDir := t.TempDir()
+	os.MkdirAll(filepath.Join(inputDir, utils.CLAIMS), 0700)
+	localDialect := "dialectURI: " + oidcClaimDialect + "\nclaims:\n  - claimURI: phone_number\n"
+	if err := ioutil.WriteFile(filepath.Join(inputDir, utils.CLAIMS, "oidc.yml"), []byte(localDialect), 0600); err != nil {
+		t.Fatal(err)
+	}
+	filePath := filepath.Join(inputDir, utils.OIDC_SCOPES, "profile.yml")
+
+	testCases := []struct {
+		description    string
+		claims         string
+		expectError    bool
+		expectedClaims []interface{}
+	}{
+		{
+			description:    "Claim deployed in the target environment",
+			claims:         "[email]",
+			expectedClaims: []interface{}{"email"},
+		},
+		{
+			description:    "Claim defined in the local claim dialect file",
+			claims:         "[email, phone_number]",
+			expectedClaims: []interface{}{"email", "phone_number"},
+		},
+		{
+			description:    "Scope without claims",
+			claims:         "[]",
+			expectedClaims: []interface{}{},
+		},
+		{
+			description: "Claim not found in the OIDC claim dialect",
+			claims:      "[email, http://wso2.org/claims/emailaddress]",
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			updatedScopes = nil
+			fileData := "name: profile\ndisplayName: Profile\nclaims: " + tc.claims
+			err := handler.UpdateResource(utils.Resource{Id: "profile", Name: "profile"}, filePath, fileData)
+			if tc.expectError {
+				if err == nil || !strings.Contains(err.Error(), "http://wso2.org/claims/emailaddress") {
+					t.Errorf("Expected an error for the missing claim, but got: %v", err)
+				}
+				if len(updatedScopes) != 0 {
+					t.Errorf("Expected the scope not to be updated, but got %d update requests", len(updatedScopes))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(updatedScopes) != 1 || !reflect.DeepEqual(updatedScopes[0]["claims"], tc.expectedClaims) {
+				t.Errorf("Expected the scope to be updated with the claims %v, but got %v", tc.expectedClaims, updatedScopes)
+			}
+		})
+	}
+}
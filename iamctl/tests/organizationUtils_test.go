@@ -0,0 +1,36 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestGetServerBaseUrl(t *testing.T) {
+
+	defer func(configs utils.ServerConfigs) { utils.SERVER_CONFIGS = configs }(utils.SERVER_CONFIGS)
+
+	testCases := []struct {
+		description    string
+		organizationId string
+		expected       string
+	}{
+		{description: "Tenant", organizationId: "", expected: "https://localhost:9443/t/carbon.super"},
+		{description: "Organization", organizationId: "b2f7c6b2", expected: "https://localhost:9443/t/carbon.super/o"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			utils.SERVER_CONFIGS = utils.ServerConfigs{
+				ServerUrl:      "https://localhost:9443",
+				TenantDomain:   "carbon.super",
+				OrganizationId: tc.organizationId,
+			}
+			if baseUrl := utils.GetServerBaseUrl(); baseUrl != tc.expected {
+				t.Errorf("Unexpected base URL: expected %s, but got %s", tc.expected, baseUrl)
+			}
+			if utils.IsOrganizationContext() != (tc.organizationId != "") {
+				t.Errorf("Unexpected organization context for organization ID: %s", tc.organizationId)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestRunInParallel(t *testing.T) {
+
+	testCases := []struct {
+		description string
+		parallelism int
+		count       int
+	}{
+		{
+			description: "Sequential execution",
+			parallelism: 1,
+			count:       10,
+		},
+		{
+			description: "Parallelism lower than the task count",
+			parallelism: 4,
+			count:       50,
+		},
+		{
+			description: "Parallelism higher than the task count",
+			parallelism: 20,
+			count:       5,
+		},
+		{
+			description: "Invalid parallelism",
+			parallelism: 0,
+			count:       5,
+		},
+		{
+			description: "No tasks",
+			parallelism: 4,
+			count:       0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			utils.PARALLELISM = tc.parallelism
+			defer func() { utils.PARALLELISM = utils.DEFAULT_PARALLELISM }()
+
+			var running, maxRunning int32
+			var mutex sync.Mutex
+			completed := make(map[int]bool)
+			utils.RunInParallel(tc.count, func(index int) {
+				current := atomic.AddInt32(&running, 1)
+				mutex.Lock()
+				if current > maxRunning {
+					maxRunning = current
+				}
+				completed[index] = true
+				mutex.Unlock()
+				atomic.AddInt32(&running, -1)
+			})
+
+			if len(completed) != tc.count {
+				t.Errorf("Expected %d tasks to be completed, but got %d", tc.count, len(completed))
+			}
+			if tc.parallelism > 0 && int(maxRunning) > tc.parallelism {
+				t.Errorf("Expected at most %d concurrent tasks, but got %d", tc.parallelism, maxRunning)
+			}
+		})
+	}
+}
+
+func TestConcurrentSummaryUpdates(t *testing.T) {
+
+	utils.SummaryData = utils.Summary{}
+	utils.ResourceSummaries = nil
+	utils.PARALLELISM = 8
+	defer func() { utils.PARALLELISM = utils.DEFAULT_PARALLELISM }()
+
+	utils.RunInParallel(100, func(index int) {
+		if index%4 == 0 {
+			utils.UpdateFailureSummary(utils.APPLICATIONS, "app")
+		} else {
+			utils.UpdateSuccessSummary(utils.APPLICATIONS, utils.IMPORT)
+		}
+	})
+
+	if utils.SummaryData.TotalRequests != 100 || utils.SummaryData.FailedOperations != 25 {
+		t.Errorf("Unexpected summary: %+v", utils.SummaryData)
+	}
+	summary := utils.ResourceSummaries[utils.APPLICATIONS]
+	if summary.SuccessfulImport != 75 || summary.Failed != 25 || len(summary.FailedResources) != 25 {
+		t.Errorf("Unexpected resource summary: %+v", summary)
+	}
+}
@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+// newTokenServer returns a server that issues an access token for every token request of the tool.
+func newTokenServer(t *testing.T) *httptest.Server {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/oauth2/token") {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "token", "token_type": "Bearer"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// capturePrintedOutput returns the text printed to the standard output by the given function.
+func capturePrintedOutput(t *testing.T, print func()) string {
+
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = writer
+	print()
+	os.Stdout = stdout
+	writer.Close()
+
+	var output bytes.Buffer
+	io.Copy(&output, reader)
+	return output.String()
+}
+
+func TestAddToPlan(t *testing.T) {
+
+	defaultServerConfigs, defaultKeywordConfigs := utils.SERVER_CONFIGS, utils.KEYWORD_CONFIGS
+	defer func() {
+		utils.SERVER_CONFIGS, utils.KEYWORD_CONFIGS = defaultServerConfigs, defaultKeywordConfigs
+		utils.ResourcePlans = nil
+		utils.ResourceResults = nil
+	}()
+	utils.ResourcePlans = nil
+	utils.ResourceResults = nil
+	utils.SERVER_CONFIGS = utils.ServerConfigs{
+		ServerUrl:     newTokenServer(t).URL,
+		TenantDomain:  "wso2.com",
+		TenantDomains: []string{"wso2.com", "abc.com"},
+	}
+
+	utils.RunForTenants(t.TempDir(), func(tenantDirPath string) {
+		utils.AddToPlan("Applications", "Pickup", utils.IMPORT)
+		utils.AddToPlan("Applications", "Dispatch", utils.UPDATE)
+		utils.AddToPlan("Roles", "Manager", utils.DELETE)
+		if strings.HasSuffix(tenantDirPath, "abc.com") {
+			utils.AddToPlan("Applications", "Console", utils.IMPORT)
+		}
+	})
+
+	expectedPlans := map[string]utils.ResourcePlan{
+		"wso2.com/Applications": {ResourceType: "Applications", Tenant: "wso2.com",
+			ToCreate: []string{"Pickup"}, ToUpdate: []string{"Dispatch"}},
+		"wso2.com/Roles": {ResourceType: "Roles", Tenant: "wso2.com", ToDelete: []string{"Manager"}},
+		"abc.com/Applications": {ResourceType: "Applications", Tenant: "abc.com",
+			ToCreate: []string{"Pickup", "Console"}, ToUpdate: []string{"Dispatch"}},
+		"abc.com/Roles": {ResourceType: "Roles", Tenant: "abc.com", ToDelete: []string{"Manager"}},
+	}
+	if !reflect.DeepEqual(utils.ResourcePlans, expectedPlans) {
+		t.Errorf("Unexpected plans: expected %v, but got %v", expectedPlans, utils.ResourcePlans)
+	}
+	for _, result := range utils.ResourceResults {
+		if result.Outcome != utils.OUTCOME_SKIPPED {
+			t.Errorf("Expected the planned operation on %s to be reported as skipped, but got %s", result.ResourceName, result.Outcome)
+		}
+	}
+	if len(utils.ResourceResults) != 7 {
+		t.Errorf("Expected 7 planned operations in the report, but got %d", len(utils.ResourceResults))
+	}
+
+	output := capturePrintedOutput(t, utils.PrintPlan)
+	for _, expected := range []string{
+		"To be created: 3\n",
+		"To be updated: 2\n",
+		"To be deleted: 2\n",
+		"Applications (Tenant: wso2.com)\n",
+		"Applications (Tenant: abc.com)\n",
+		"Create (2): Pickup, Console\n",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected the plan to contain %q, but got:\n%s", expected, output)
+		}
+	}
+}
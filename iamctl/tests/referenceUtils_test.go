@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+type testDeployedResourceHandler struct {
+	testSplitResourceHandler
+	deployedResources []utils.Resource
+}
+
+func (h *testDeployedResourceHandler) GetDeployedResources() ([]utils.Resource, error) {
+	return h.deployedResources, nil
+}
+
+type testReferenceResolver struct {
+	testResourceHandler
+	referencedType string
+}
+
+func (h *testReferenceResolver) ResolveLocalResource(fileData []byte, fileInfo utils.FileInfo,
+	inventory *utils.ResourceInventory) (utils.Resource, error) {
+	return utils.Resource{Name: fileInfo.ResourceName}, nil
+}
+
+func (h *testReferenceResolver) GetReferences(fileData []byte) (map[string][]string, error) {
+	return map[string][]string{h.referencedType: strings.Fields(string(fileData))}, nil
+}
+
+func TestGetInvalidReferences(t *testing.T) {
+
+	inputDir := t.TempDir()
+	files := map[string]string{
+		"RefIdps/Google.yml":   "Google",
+		"RefApps/Pickup.yml":   "Google Facebook Gogle",
+		"RefApps/Dispatch.yml": "Google",
+	}
+	for filePath, content := range files {
+		os.MkdirAll(filepath.Join(inputDir, filepath.Dir(filePath)), 0700)
+		if err := ioutil.WriteFile(filepath.Join(inputDir, filePath), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	idpHandler := &testDeployedResourceHandler{
+		testSplitResourceHandler: testSplitResourceHandler{testResourceHandler{resourceType: "RefIdps"}},
+		deployedResources:        []utils.Resource{{Id: "1", Name: "Facebook"}},
+	}
+
+	testCases := []struct {
+		description     string
+		handlers        []utils.ResourceHandler
+		resourceConfigs map[string]map[string]interface{}
+		expected        []utils.InvalidReference
+	}{
+		{
+			description: "References to local and deployed resources",
+			handlers: []utils.ResourceHandler{
+				idpHandler,
+				&testReferenceResolver{testResourceHandler{resourceType: "RefApps"}, "RefIdps"},
+			},
+			expected: []utils.InvalidReference{
+				{ResourceType: "RefApps", ResourceName: "Pickup", ReferencedType: "RefIdps", ReferencedName: "Gogle"},
+			},
+		},
+		{
+			description: "References of excluded local resources and to excluded local resources",
+			handlers: []utils.ResourceHandler{
+				idpHandler,
+				&testReferenceResolver{testResourceHandler{resourceType: "RefApps"}, "RefIdps"},
+			},
+			resourceConfigs: map[string]map[string]interface{}{
+				"RefApps": {utils.EXCLUDE_CONFIG: []interface{}{"Pickup"}},
+				"RefIdps": {utils.EXCLUDE_CONFIG: []interface{}{"Google"}},
+			},
+			expected: []utils.InvalidReference{
+				{ResourceType: "RefApps", ResourceName: "Dispatch", ReferencedType: "RefIdps", ReferencedName: "Google"},
+			},
+		},
+		{
+			description: "References to a resource type that is not handled",
+			handlers: []utils.ResourceHandler{
+				&testReferenceResolver{testResourceHandler{resourceType: "RefApps"}, "RefClaims"},
+			},
+			expected: nil,
+		},
+	}
+	defaultToolConfigs := utils.TOOL_CONFIGS
+	defer func() { utils.TOOL_CONFIGS = defaultToolConfigs }()
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			utils.TOOL_CONFIGS = utils.ToolConfigs{ResourceConfigs: tc.resourceConfigs}
+			invalidReferences := utils.GetInvalidReferences(tc.handlers, inputDir)
+			if !reflect.DeepEqual(invalidReferences, tc.expected) {
+				t.Errorf("Unexpected invalid references: expected %v, but got %v", tc.expected, invalidReferences)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestWriteReport(t *testing.T) {
+
+	tempDir, err := ioutil.TempDir("", "report")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	utils.ResourceResults = nil
+	utils.AddResourceResult(utils.APPLICATIONS, "App 1", utils.IMPORT, nil, time.Now())
+	utils.AddResourceResult(utils.APPLICATIONS, "App 2", utils.UPDATE, errors.New("update failed"), time.Now())
+	utils.AddSkippedResourceResult(utils.IDENTITY_PROVIDERS, "Google", utils.EXCLUDE, "excluded")
+
+	testCases := []struct {
+		description  string
+		reportFormat string
+		reportFile   string
+		expected     []string
+		expectError  bool
+	}{
+		{
+			description:  "JSON report",
+			reportFormat: utils.REPORT_FORMAT_JSON,
+			reportFile:   "report.json",
+			expected:     []string{`"resourceName": "App 2"`, `"outcome": "failure"`, `"error": "update failed"`, `"totalRequests"`},
+		},
+		{
+			description:  "JUnit report",
+			reportFormat: utils.REPORT_FORMAT_JUNIT,
+			reportFile:   "report.xml",
+			expected:     []string{`<testsuites name="iamctl import" tests="3" failures="1" skipped="1"`, `<failure message="update failed">`, `<skipped message="excluded">`},
+		},
+		{
+			description: "Report format resolved from the file extension",
+			reportFile:  "results.xml",
+			expected:    []string{`<testsuite name="Applications" tests="2" failures="1" skipped="0"`},
+		},
+		{
+			description:  "Unsupported report format",
+			reportFormat: "html",
+			reportFile:   "report.html",
+			expectError:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			reportFile := filepath.Join(tempDir, tc.reportFile)
+			err := utils.WriteReport(tc.reportFormat, reportFile, utils.IMPORT)
+			if tc.expectError {
+				if err == nil {
+					t.Errorf("Expected an error for %s", tc.description)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			content, err := ioutil.ReadFile(reportFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, expected := range tc.expected {
+				if !strings.Contains(string(content), expected) {
+					t.Errorf("Expected report to contain %s, but got:\n%s", expected, content)
+				}
+			}
+			if tc.reportFormat == utils.REPORT_FORMAT_JSON {
+				var report utils.Report
+				if err := json.Unmarshal(content, &report); err != nil || len(report.Resources) != 3 {
+					t.Errorf("Invalid JSON report: %s", err)
+				}
+			}
+		})
+	}
+}
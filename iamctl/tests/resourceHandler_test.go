@@ -0,0 +1,192 @@
+package tests
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+type testResourceHandler struct {
+	resourceType string
+	dependencies []string
+}
+
+func (h *testResourceHandler) GetResourceType() string                      { return h.resourceType }
+func (h *testResourceHandler) GetConfigKey() string                         { return h.resourceType }
+func (h *testResourceHandler) GetDependencies() []string                    { return h.dependencies }
+func (h *testResourceHandler) GetArrayIdentifiers() map[string]string       { return map[string]string{} }
+func (h *testResourceHandler) GetFileName(resource utils.Resource) string   { return resource.Name }
+func (h *testResourceHandler) IsDeletable(resource utils.Resource) bool     { return true }
+func (h *testResourceHandler) DeleteResource(resource utils.Resource) error { return nil }
+
+func (h *testResourceHandler) GetDeployedResources() ([]utils.Resource, error) {
+	return nil, nil
+}
+
+func (h *testResourceHandler) ExportResource(resource utils.Resource, format string, excludeSecrets bool) (string, []byte, error) {
+	return "", nil, nil
+}
+
+func (h *testResourceHandler) ResolveLocalResource(fileData []byte, fileInfo utils.FileInfo,
+	inventory *utils.ResourceInventory) (utils.Resource, error) {
+	return utils.Resource{}, nil
+}
+
+func (h *testResourceHandler) ImportResource(resource utils.Resource, filePath string, fileData string) (string, error) {
+	return "", nil
+}
+
+func (h *testResourceHandler) UpdateResource(resource utils.Resource, filePath string, fileData string) error {
+	return nil
+}
+
+func TestGetResourceHandlers(t *testing.T) {
+
+	utils.RegisterResourceHandler(&testResourceHandler{resourceType: "TestApps", dependencies: []string{"TestIdps", "TestClaims"}})
+	utils.RegisterResourceHandler(&testResourceHandler{resourceType: "TestIdps", dependencies: []string{"TestClaims"}})
+	utils.RegisterResourceHandler(&testResourceHandler{resourceType: "TestClaims"})
+	utils.RegisterResourceHandler(&testResourceHandler{resourceType: "TestBranding", dependencies: []string{"TestUnknown"}})
+
+	var resourceTypes []string
+	for _, handler := range utils.GetResourceHandlers() {
+		// Skip the handlers of the resource type packages imported by the other tests.
+		if strings.HasPrefix(handler.GetResourceType(), "Test") {
+			resourceTypes = append(resourceTypes, handler.GetResourceType())
+		}
+	}
+	expected := []string{"TestClaims", "TestIdps", "TestApps", "TestBranding"}
+	if !reflect.DeepEqual(resourceTypes, expected) {
+		t.Errorf("Unexpected resource handler order: expected %v, but got %v", expected, resourceTypes)
+	}
+	if utils.GetResourceHandler("TestIdps") == nil || utils.GetResourceHandler("TestUnknown") != nil {
+		t.Errorf("Unexpected result when retrieving resource handlers by resource type")
+	}
+	if handler, err := utils.FindResourceHandler("testidps"); err != nil || handler.GetResourceType() != "TestIdps" {
+		t.Errorf("Unexpected result when finding the resource handler ignoring the case: %v, %v", handler, err)
+	}
+	if _, err := utils.FindResourceHandler("TestUnknown"); err == nil || !strings.Contains(err.Error(), "TestClaims") {
+		t.Errorf("Expected an error listing the supported resource types, but got: %v", err)
+	}
+}
+
+type testSplitResourceHandler struct {
+	testResourceHandler
+}
+
+func (h *testSplitResourceHandler) SplitLocalFile(fileData []byte, fileInfo utils.FileInfo) ([][]byte, error) {
+
+	var resourcesData [][]byte
+	for _, line := range strings.Split(strings.TrimSpace(string(fileData)), "\n") {
+		resourcesData = append(resourcesData, []byte(line))
+	}
+	return resourcesData, nil
+}
+
+func (h *testSplitResourceHandler) ResolveLocalResource(fileData []byte, fileInfo utils.FileInfo,
+	inventory *utils.ResourceInventory) (utils.Resource, error) {
+
+	resource := utils.Resource{Name: string(fileData)}
+	if deployedResource, ok := inventory.GetByName(resource.Name); ok {
+		resource.Id = deployedResource.Id
+	}
+	return resource, nil
+}
+
+func TestResolveLocalFile(t *testing.T) {
+
+	filePath := filepath.Join(t.TempDir(), "users.csv")
+	if err := ioutil.WriteFile(filePath, []byte("alice\nbob\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	inventory := utils.NewResourceInventory([]utils.Resource{{Id: "1", Name: "bob"}})
+
+	testCases := []struct {
+		handler  utils.ResourceHandler
+		expected []utils.Resource
+	}{
+		{
+			handler:  &testSplitResourceHandler{testResourceHandler{resourceType: "TestUsers"}},
+			expected: []utils.Resource{{Name: "alice"}, {Id: "1", Name: "bob"}},
+		},
+		{
+			handler:  &testResourceHandler{resourceType: "TestRoles"},
+			expected: []utils.Resource{{}},
+		},
+	}
+	for _, test := range testCases {
+		localResources, err := utils.ResolveLocalFile(test.handler, filePath, inventory)
+		if err != nil {
+			t.Fatalf("Unexpected error for %s: %s", test.handler.GetResourceType(), err)
+		}
+		var resources []utils.Resource
+		for _, localResource := range localResources {
+			resources = append(resources, localResource.Resource)
+		}
+		if !reflect.DeepEqual(resources, test.expected) {
+			t.Errorf("Unexpected resources for %s: expected %v, but got %v", test.handler.GetResourceType(), test.expected, resources)
+		}
+	}
+}
+
+type testRecordingResourceHandler struct {
+	testSplitResourceHandler
+	deployed []utils.Resource
+	mutex    sync.Mutex
+	imported []string
+	updated  []string
+}
+
+func (h *testRecordingResourceHandler) GetDeployedResources() ([]utils.Resource, error) {
+	return h.deployed, nil
+}
+
+func (h *testRecordingResourceHandler) ImportResource(resource utils.Resource, filePath string, fileData string) (string, error) {
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.imported = append(h.imported, resource.Name)
+	return "new-" + resource.Name, nil
+}
+
+func (h *testRecordingResourceHandler) UpdateResource(resource utils.Resource, filePath string, fileData string) error {
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.updated = append(h.updated, resource.Name)
+	return nil
+}
+
+func TestImportLocalFiles(t *testing.T) {
+
+	inputDir := t.TempDir()
+	files := map[string]string{
+		"team-a.csv": "alice\nbob\n",
+		"team-b.csv": "carol\n",
+		"team-c.csv": "dave\n",
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(inputDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	handler := &testRecordingResourceHandler{
+		testSplitResourceHandler: testSplitResourceHandler{testResourceHandler{resourceType: "TestImportUsers"}},
+		deployed:                 []utils.Resource{{Id: "1", Name: "bob"}, {Id: "2", Name: "erin"}},
+	}
+
+	utils.ImportLocalFiles(handler, []string{filepath.Join(inputDir, "team-a.csv"), filepath.Join(inputDir, "team-b.csv")})
+
+	sort.Strings(handler.imported)
+	if expected := []string{"alice", "carol"}; !reflect.DeepEqual(handler.imported, expected) {
+		t.Errorf("Unexpected imported resources: expected %v, but got %v", expected, handler.imported)
+	}
+	if expected := []string{"bob"}; !reflect.DeepEqual(handler.updated, expected) {
+		t.Errorf("Unexpected updated resources: expected %v, but got %v", expected, handler.updated)
+	}
+}
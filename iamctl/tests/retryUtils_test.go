@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestGetRetryDelay(t *testing.T) {
+
+	testCases := []struct {
+		description string
+		attempt     int
+		retryAfter  string
+		minDelay    time.Duration
+		maxDelay    time.Duration
+	}{
+		{
+			description: "Retry-After header in seconds",
+			attempt:     1,
+			retryAfter:  "5",
+			minDelay:    5 * time.Second,
+			maxDelay:    5 * time.Second,
+		},
+		{
+			description: "Retry-After header capped at the maximum backoff",
+			attempt:     1,
+			retryAfter:  "86400",
+			minDelay:    utils.DEFAULT_MAX_RETRY_BACKOFF * time.Second,
+			maxDelay:    utils.DEFAULT_MAX_RETRY_BACKOFF * time.Second,
+		},
+		{
+			description: "First attempt without Retry-After header",
+			attempt:     1,
+			minDelay:    0,
+			maxDelay:    utils.INITIAL_RETRY_BACKOFF,
+		},
+		{
+			description: "Third attempt without Retry-After header",
+			attempt:     3,
+			minDelay:    0,
+			maxDelay:    4 * utils.INITIAL_RETRY_BACKOFF,
+		},
+		{
+			description: "Backoff capped at the maximum backoff",
+			attempt:     40,
+			minDelay:    0,
+			maxDelay:    utils.DEFAULT_MAX_RETRY_BACKOFF * time.Second,
+		},
+		{
+			description: "Invalid Retry-After header",
+			attempt:     1,
+			retryAfter:  "later",
+			minDelay:    0,
+			maxDelay:    utils.INITIAL_RETRY_BACKOFF,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tc.retryAfter != "" {
+				resp.Header.Set("Retry-After", tc.retryAfter)
+			}
+			for i := 0; i < 20; i++ {
+				delay := utils.GetRetryDelay(tc.attempt, resp)
+				if delay < tc.minDelay || delay > tc.maxDelay {
+					t.Errorf("Unexpected delay for %s: expected between %v and %v, but got %v", tc.description, tc.minDelay, tc.maxDelay, delay)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package tests
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+type testRollbackResource struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+// testRollbackHandler keeps the deployed resources in memory, and fails to create a resource with the value "fail".
+type testRollbackHandler struct {
+	testResourceHandler
+	deployed map[string]string
+	mutex    sync.Mutex
+}
+
+func (h *testRollbackHandler) GetDeployedResources() ([]utils.Resource, error) {
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	var resources []utils.Resource
+	for name := range h.deployed {
+		resources = append(resources, utils.Resource{Id: name, Name: name})
+	}
+	return resources, nil
+}
+
+func (h *testRollbackHandler) ExportResource(resource utils.Resource, format string, excludeSecrets bool) (string, []byte, error) {
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	content, err := yaml.Marshal(testRollbackResource{Name: resource.Name, Value: h.deployed[resource.Name]})
+	return resource.Name + ".yml", content, err
+}
+
+func (h *testRollbackHandler) ResolveLocalResource(fileData []byte, fileInfo utils.FileInfo,
+	inventory *utils.ResourceInventory) (utils.Resource, error) {
+
+	var localResource testRollbackResource
+	if err := yaml.Unmarshal(fileData, &localResource); err != nil {
+		return utils.Resource{}, err
+	}
+	resource := utils.Resource{Name: localResource.Name}
+	if deployedResource, ok := inventory.GetByName(localResource.Name); ok {
+		resource.Id = deployedResource.Id
+	}
+	return resource, nil
+}
+
+func (h *testRollbackHandler) ImportResource(resource utils.Resource, filePath string, fileData string) (string, error) {
+
+	var localResource testRollbackResource
+	if err := yaml.Unmarshal([]byte(fileData), &localResource); err != nil {
+		return "", err
+	}
+	if localResource.Value == "fail" {
+		return "", fmt.Errorf("resource rejected by the server")
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.deployed[localResource.Name] = localResource.Value
+	return localResource.Name, nil
+}
+
+func (h *testRollbackHandler) UpdateResource(resource utils.Resource, filePath string, fileData string) error {
+
+	var localResource testRollbackResource
+	if err := yaml.Unmarshal([]byte(fileData), &localResource); err != nil {
+		return err
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.deployed[resource.Name] = localResource.Value
+	return nil
+}
+
+func (h *testRollbackHandler) DeleteResource(resource utils.Resource) error {
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	delete(h.deployed, resource.Name)
+	return nil
+}
+
+func TestImportResourceTypesRollback(t *testing.T) {
+
+	defaultToolConfigs, defaultSnapshotDir := utils.TOOL_CONFIGS, utils.SNAPSHOT_DIR
+	defer func() {
+		utils.TOOL_CONFIGS, utils.SNAPSHOT_DIR, utils.ROLLBACK_ON_FAILURE = defaultToolConfigs, defaultSnapshotDir, false
+	}()
+	utils.TOOL_CONFIGS = utils.ToolConfigs{AllowDelete: true}
+	utils.SNAPSHOT_DIR = t.TempDir()
+	utils.ROLLBACK_ON_FAILURE = true
+
+	// The import updates Updated, creates Created, deletes Deleted and fails to create Rejected.
+	inputDir := t.TempDir()
+	files := map[string]string{
+		"Updated.yml":  "name: Updated\nvalue: new",
+		"Created.yml":  "name: Created\nvalue: new",
+		"Rejected.yml": "name: Rejected\nvalue: fail",
+	}
+	os.MkdirAll(filepath.Join(inputDir, "RollbackApps"), 0700)
+	for fileName, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(inputDir, "RollbackApps", fileName), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	handler := &testRollbackHandler{
+		testResourceHandler: testResourceHandler{resourceType: "RollbackApps"},
+		deployed:            map[string]string{"Updated": "old", "Deleted": "old"},
+	}
+
+	utils.ImportResourceTypes([]utils.ResourceHandler{handler}, inputDir)
+
+	expected := map[string]string{"Updated": "old", "Deleted": "old"}
+	if !reflect.DeepEqual(handler.deployed, expected) {
+		t.Errorf("Unexpected deployed resources after the rollback: expected %v, but got %v", expected, handler.deployed)
+	}
+}
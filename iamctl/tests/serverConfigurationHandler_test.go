@@ -0,0 +1,161 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	_ "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/serverConfigurations"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// newGovernanceServer returns a server with the account management category, and records the properties patched for
+// each connector.
+func newGovernanceServer(t *testing.T, patchedProperties map[string][]interface{}) *httptest.Server {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/identity-governance/YWNjb3VudA"):
+			w.Write([]byte(`[
+				{"id": "bG9jaw", "name": "account.lock.handler", "friendlyName": "Account Lock", "properties": [
+					{"name": "account.lock.handler.enable", "value": "false", "readOnly": false},
+					{"name": "account.lock.handler.version", "value": "2", "readOnly": true}
+				]},
+				{"id": "ZGlzYWJsZQ", "name": "account.disable.handler", "friendlyName": "Account Disable", "properties": [
+					{"name": "account.disable.handler.enable", "value": "false", "readOnly": false}
+				]}
+			]`))
+		case r.Method == http.MethodPatch && strings.Contains(r.URL.Path, "/identity-governance/YWNjb3VudA/connectors/"):
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			connectorId := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			patchedProperties[connectorId], _ = body["properties"].([]interface{})
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestExportServerConfigurationsWithoutReadOnlyProperties(t *testing.T) {
+
+	defaultServerConfigs := utils.SERVER_CONFIGS
+	defer func() {
+		utils.SERVER_CONFIGS = defaultServerConfigs
+	}()
+	server := newGovernanceServer(t, map[string][]interface{}{})
+	utils.SERVER_CONFIGS = utils.ServerConfigs{ServerUrl: server.URL, TenantDomain: "carbon.super", Token: "token"}
+
+	handler := utils.GetResourceHandler(utils.SERVER_CONFIGURATIONS)
+	if handler == nil {
+		t.Fatal("Server configuration handler is not registered")
+	}
+	fileName, content, err := handler.ExportResource(utils.Resource{Id: "YWNjb3VudA", Name: "Account Management"}, "yaml", true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if fileName != "Account_Management.yml" {
+		t.Errorf("Expected the file name Account_Management.yml, but got %s", fileName)
+	}
+
+	var exported struct {
+		Connectors []struct {
+			Name       string `yaml:"name"`
+			Properties []struct {
+				Name string `yaml:"name"`
+			} `yaml:"properties"`
+		} `yaml:"connectors"`
+	}
+	if err := yaml.Unmarshal(content, &exported); err != nil {
+		t.Fatalf("Invalid exported content: %s", err)
+	}
+	var exportedProperties []string
+	for _, connector := range exported.Connectors {
+		for _, property := range connector.Properties {
+			exportedProperties = append(exportedProperties, property.Name)
+		}
+	}
+	expected := []string{"account.lock.handler.enable", "account.disable.handler.enable"}
+	if !reflect.DeepEqual(exportedProperties, expected) {
+		t.Errorf("Expected the exported properties %v, but got %v", expected, exportedProperties)
+	}
+	if strings.Contains(string(content), "readOnly") {
+		t.Errorf("Expected the readOnly flag not to be exported, but got:\n%s", content)
+	}
+}
+
+func TestUpdateServerConfigurations(t *testing.T) {
+
+	defaultServerConfigs := utils.SERVER_CONFIGS
+	defer func() {
+		utils.SERVER_CONFIGS = defaultServerConfigs
+	}()
+
+	testCases := []struct {
+		description     string
+		fileData        string
+		expectError     bool
+		expectedPatches map[string][]interface{}
+	}{
+		{
+			description: "Properties are patched on the connector with the same name",
+			fileData: `name: Account Management
+connectors:
+  - name: account.disable.handler
+    properties:
+      - name: account.disable.handler.enable
+        value: "true"
+`,
+			expectedPatches: map[string][]interface{}{
+				"ZGlzYWJsZQ": {map[string]interface{}{"name": "account.disable.handler.enable", "value": "true"}},
+			},
+		},
+		{
+			description: "Connector without properties is not patched",
+			fileData: `name: Account Management
+connectors:
+  - name: account.lock.handler
+    properties: []
+`,
+			expectedPatches: map[string][]interface{}{},
+		},
+		{
+			description: "Connector not found in the target environment",
+			fileData: `name: Account Management
+connectors:
+  - name: account.unknown.handler
+    properties:
+      - name: account.unknown.handler.enable
+        value: "true"
+`,
+			expectError:     true,
+			expectedPatches: map[string][]interface{}{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			patchedProperties := map[string][]interface{}{}
+			server := newGovernanceServer(t, patchedProperties)
+			utils.SERVER_CONFIGS = utils.ServerConfigs{ServerUrl: server.URL, TenantDomain: "carbon.super", Token: "token"}
+
+			handler := utils.GetResourceHandler(utils.SERVER_CONFIGURATIONS)
+			err := handler.UpdateResource(utils.Resource{Id: "YWNjb3VudA", Name: "Account Management"}, "", tc.fileData)
+			if tc.expectError {
+				if err == nil || !strings.Contains(err.Error(), "account.unknown.handler") {
+					t.Errorf("Expected an error for the missing connector, but got: %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(patchedProperties, tc.expectedPatches) {
+				t.Errorf("Expected the patched properties %v, but got %v", tc.expectedPatches, patchedProperties)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestGetTemplateTypeIdFromInventory(t *testing.T) {
+
+	inventory := utils.NewResourceInventory([]utils.Resource{
+		{Id: utils.GetTemplateResourceId("type-1", "en_US"), Name: utils.GetTemplateResourceName("AccountConfirmation", "en_US")},
+		{Id: utils.GetTemplateResourceId("type-2", "fr_FR"), Name: utils.GetTemplateResourceName("Account_Confirmation", "fr_FR")},
+	})
+	manager := utils.NewTemplateTypeManager("/api/server/v1/email/template-types", utils.EMAIL_TEMPLATES)
+
+	testCases := []struct {
+		templateType string
+		expectedId   string
+	}{
+		{templateType: "AccountConfirmation", expectedId: "type-1"},
+		{templateType: "accountconfirmation", expectedId: "type-1"},
+		{templateType: "Account_Confirmation", expectedId: "type-2"},
+	}
+	for _, tc := range testCases {
+		templateTypeId, err := manager.GetTemplateTypeId(tc.templateType, inventory)
+		if err != nil {
+			t.Errorf("Unexpected error for template type %s: %s", tc.templateType, err)
+		}
+		if templateTypeId != tc.expectedId {
+			t.Errorf("Expected template type ID %s for %s, but got %s", tc.expectedId, tc.templateType, templateTypeId)
+		}
+	}
+}
+
+func TestSplitTemplateResourceId(t *testing.T) {
+
+	templateTypeId, locale := utils.SplitTemplateResourceId(utils.GetTemplateResourceId("dHlwZQ", "en_US"))
+	if templateTypeId != "dHlwZQ" || locale != "en_US" {
+		t.Errorf("Expected template type ID dHlwZQ and locale en_US, but got %s and %s", templateTypeId, locale)
+	}
+}
@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestResolveTenantKeywordConfigs(t *testing.T) {
+
+	defaultKeywordConfigs := utils.KeywordConfigs{
+		KeywordMappings: map[string]interface{}{"SERVER": "dev.com", "CALLBACK": "https://dev.com/callback"},
+		ResourceConfigs: map[string]map[string]interface{}{
+			utils.APPLICATIONS_CONFIG: {"Pickup": map[string]interface{}{}},
+		},
+		TenantConfigs: map[string]json.RawMessage{
+			"wso2.com": json.RawMessage(`{
+				"KEYWORD_MAPPINGS": {"SERVER": "wso2.com"},
+				"APPLICATIONS": {"Dispatch": {"KEYWORD_MAPPINGS": {"CALLBACK": "https://wso2.com/dispatch"}}}
+			}`),
+		},
+	}
+
+	testCases := []struct {
+		description        string
+		tenantDomain       string
+		expectedMappings   map[string]interface{}
+		expectedAppConfigs []string
+	}{
+		{
+			description:        "Tenant with overrides",
+			tenantDomain:       "wso2.com",
+			expectedMappings:   map[string]interface{}{"SERVER": "wso2.com", "CALLBACK": "https://dev.com/callback"},
+			expectedAppConfigs: []string{"Dispatch", "Pickup"},
+		},
+		{
+			description:        "Tenant without overrides",
+			tenantDomain:       "abc.com",
+			expectedMappings:   map[string]interface{}{"SERVER": "dev.com", "CALLBACK": "https://dev.com/callback"},
+			expectedAppConfigs: []string{"Pickup"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			keywordConfigs, err := utils.ResolveTenantKeywordConfigs(defaultKeywordConfigs, tc.tenantDomain)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(keywordConfigs.KeywordMappings, tc.expectedMappings) {
+				t.Errorf("Unexpected keyword mappings: expected %v, but got %v", tc.expectedMappings, keywordConfigs.KeywordMappings)
+			}
+			for _, appName := range tc.expectedAppConfigs {
+				if _, ok := keywordConfigs.ResourceConfigs[utils.APPLICATIONS_CONFIG][appName]; !ok {
+					t.Errorf("Resource configs not found for the application: %s", appName)
+				}
+			}
+		})
+	}
+	if defaultKeywordConfigs.KeywordMappings["SERVER"] != "dev.com" {
+		t.Errorf("Default keyword mappings are modified: %v", defaultKeywordConfigs.KeywordMappings)
+	}
+}
@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+// tokenTestServer issues a new access token for each token request, and accepts API requests with the token given by
+// acceptedToken.
+type tokenTestServer struct {
+	*httptest.Server
+	expiresIn     int
+	tokenRequests int32
+	apiRequests   int32
+	acceptedToken func(token string) bool
+}
+
+func newTokenTestServer(t *testing.T, expiresIn int, acceptedToken func(token string) bool) *tokenTestServer {
+
+	server := &tokenTestServer{expiresIn: expiresIn, acceptedToken: acceptedToken}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/oauth2/token") {
+			count := atomic.AddInt32(&server.tokenRequests, 1)
+			fmt.Fprintf(w, `{"access_token": "token-%d", "expires_in": %d}`, count, server.expiresIn)
+			return
+		}
+		atomic.AddInt32(&server.apiRequests, 1)
+		if !server.acceptedToken(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// useTokenServer targets the given server and gets the initial access token from it.
+func useTokenServer(t *testing.T, server *tokenTestServer) {
+
+	utils.SERVER_CONFIGS = utils.ServerConfigs{ServerUrl: server.URL, TenantDomain: "carbon.super"}
+	if err := utils.RefreshAccessToken(); err != nil {
+		t.Fatalf("Unexpected error when getting the access token: %s", err)
+	}
+}
+
+// resetAccessToken gets an access token without an expiry time, so that the other tests are not affected.
+func resetAccessToken(t *testing.T, defaultServerConfigs utils.ServerConfigs) {
+
+	utils.SERVER_CONFIGS = utils.ServerConfigs{ServerUrl: newTokenServer(t).URL, TenantDomain: "carbon.super"}
+	utils.RefreshAccessToken()
+	utils.SERVER_CONFIGS = defaultServerConfigs
+}
+
+func TestAccessTokenExpiryBuffer(t *testing.T) {
+
+	defaultServerConfigs := utils.SERVER_CONFIGS
+	defer resetAccessToken(t, defaultServerConfigs)
+
+	testCases := []struct {
+		description           string
+		expiresIn             int
+		expectedTokenRequests int32
+	}{
+		{
+			description:           "Token expiring within the buffer is refreshed",
+			expiresIn:             30,
+			expectedTokenRequests: 2,
+		},
+		{
+			description:           "Token expiring after the buffer is reused",
+			expiresIn:             3600,
+			expectedTokenRequests: 1,
+		},
+		{
+			description:           "Token without an expiry time is reused",
+			expiresIn:             0,
+			expectedTokenRequests: 1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			server := newTokenTestServer(t, tc.expiresIn, func(token string) bool { return true })
+			useTokenServer(t, server)
+
+			if _, _, err := utils.SendJsonRequest(http.MethodGet, server.URL+"/api/test", nil, ""); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if tokenRequests := atomic.LoadInt32(&server.tokenRequests); tokenRequests != tc.expectedTokenRequests {
+				t.Errorf("Expected %d token requests, but got %d", tc.expectedTokenRequests, tokenRequests)
+			}
+		})
+	}
+}
+
+func TestReauthenticationOnUnauthorizedResponse(t *testing.T) {
+
+	defaultServerConfigs, defaultToolConfigs := utils.SERVER_CONFIGS, utils.TOOL_CONFIGS
+	defer func() {
+		utils.TOOL_CONFIGS = defaultToolConfigs
+		resetAccessToken(t, defaultServerConfigs)
+	}()
+	utils.TOOL_CONFIGS = utils.ToolConfigs{MaxRetryAttempts: 1}
+
+	testCases := []struct {
+		description         string
+		acceptedToken       func(token string) bool
+		expectError         bool
+		expectedApiRequests int32
+	}{
+		{
+			description:         "Request is retried with the new token",
+			acceptedToken:       func(token string) bool { return token != "token-1" },
+			expectedApiRequests: 2,
+		},
+		{
+			description:         "Request is not retried again if the new token is rejected",
+			acceptedToken:       func(token string) bool { return false },
+			expectError:         true,
+			expectedApiRequests: 2,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			server := newTokenTestServer(t, 0, tc.acceptedToken)
+			useTokenServer(t, server)
+
+			_, statusCode, err := utils.SendJsonRequest(http.MethodPost, server.URL+"/api/test", map[string]string{}, "")
+			if tc.expectError {
+				if err == nil || statusCode != http.StatusUnauthorized {
+					t.Errorf("Expected an unauthorized error, but got status code %d", statusCode)
+				}
+			} else if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+			}
+			// The initial token request and a single re-authentication.
+			if tokenRequests := atomic.LoadInt32(&server.tokenRequests); tokenRequests != 2 {
+				t.Errorf("Expected 2 token requests, but got %d", tokenRequests)
+			}
+			if apiRequests := atomic.LoadInt32(&server.apiRequests); apiRequests != tc.expectedApiRequests {
+				t.Errorf("Expected %d API requests, but got %d", tc.expectedApiRequests, apiRequests)
+			}
+		})
+	}
+}
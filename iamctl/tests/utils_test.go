@@ -77,6 +77,36 @@ func TestIsResourceExcluded(t *testing.T) {
 			},
 			expectedResult: false,
 		},
+		{
+			name:         "IncludeOnlyConfig: Resource matching a glob pattern not excluded",
+			resourceName: "team-payments-api",
+			resourceConfigs: map[string]interface{}{
+				"INCLUDE_ONLY": []interface{}{
+					"team-payments-*",
+				},
+			},
+			expectedResult: false,
+		},
+		{
+			name:         "ExcludeConfig: Resource matching a regex pattern excluded",
+			resourceName: "team-orders-web",
+			resourceConfigs: map[string]interface{}{
+				"EXCLUDE": []interface{}{
+					"regex:team-(payments|orders)-.+",
+				},
+			},
+			expectedResult: true,
+		},
+		{
+			name:         "IncludeOnlyConfig: Attribute pattern not matched without attributes",
+			resourceName: "resource1",
+			resourceConfigs: map[string]interface{}{
+				"INCLUDE_ONLY": []interface{}{
+					"attr:inboundProtocol=oauth2",
+				},
+			},
+			expectedResult: true,
+		},
 	}
 
 	for _, tc := range testCases {